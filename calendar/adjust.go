@@ -0,0 +1,81 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// Convention is the business day convention used to adjust a date that does
+// not fall on a business day.
+type Convention int
+
+// Business day conventions.
+const (
+	Unadjusted        Convention = 1
+	Following         Convention = 2
+	ModifiedFollowing Convention = 3
+	Preceding         Convention = 4
+	ModifiedPreceding Convention = 5
+)
+
+func (c Convention) String() string {
+	switch c {
+	case Unadjusted:
+		return "Unadjusted"
+	case Following:
+		return "Following"
+	case ModifiedFollowing:
+		return "Modified Following"
+	case Preceding:
+		return "Preceding"
+	case ModifiedPreceding:
+		return "Modified Preceding"
+	}
+	return fmt.Sprintf("Convention(%d)", int(c))
+}
+
+// Adjust adjusts the date to a business day using the business day
+// convention.
+//
+//   - Following moves to the next business day.
+//   - Modified Following moves to the next business day unless it falls in
+//     the next month, in which case it moves to the previous business day.
+//   - Preceding moves to the previous business day.
+//   - Modified Preceding moves to the previous business day unless it falls
+//     in the previous month, in which case it moves to the next business day.
+func (c Calendar) Adjust(t time.Time, convention Convention) time.Time {
+	if convention == Unadjusted || c.IsBusinessDay(t) {
+		return t
+	}
+	switch convention {
+	case Following:
+		return c.roll(t, 1)
+	case ModifiedFollowing:
+		if adjusted := c.roll(t, 1); adjusted.Month() == t.Month() {
+			return adjusted
+		}
+		return c.roll(t, -1)
+	case Preceding:
+		return c.roll(t, -1)
+	case ModifiedPreceding:
+		if adjusted := c.roll(t, -1); adjusted.Month() == t.Month() {
+			return adjusted
+		}
+		return c.roll(t, 1)
+	}
+	return t
+}
+
+// roll moves the date one day at a time in the given direction until it falls
+// on a business day.
+func (c Calendar) roll(t time.Time, step int) time.Time {
+	for !c.IsBusinessDay(t) {
+		t = t.AddDate(0, 0, step)
+	}
+	return t
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdjust(t *testing.T) {
+	us := US()
+	testCases := []struct {
+		given      time.Time
+		convention Convention
+		expected   time.Time
+	}{
+		{date(2024, 6, 30), Unadjusted, date(2024, 6, 30)},
+		{date(2024, 6, 30), Following, date(2024, 7, 1)},
+		{date(2024, 6, 30), ModifiedFollowing, date(2024, 6, 28)},
+		{date(2024, 6, 30), Preceding, date(2024, 6, 28)},
+		{date(2024, 6, 1), ModifiedPreceding, date(2024, 6, 3)},
+		{date(2024, 6, 16), ModifiedPreceding, date(2024, 6, 14)},
+		{date(2024, 7, 4), Following, date(2024, 7, 5)},
+		{date(2024, 7, 3), Following, date(2024, 7, 3)},
+	}
+	for _, tc := range testCases {
+		if got := us.Adjust(tc.given, tc.convention); !got.Equal(tc.expected) {
+			t.Errorf("%s adjusted %s = %s, expected = %s",
+				tc.convention, tc.given.Format("2006-01-02"), got.Format("2006-01-02"), tc.expected.Format("2006-01-02"))
+		}
+	}
+}
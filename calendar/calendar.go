@@ -0,0 +1,287 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package calendar
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Rule returns the holidays falling in the given year, such as a fixed date
+// or the fourth Thursday of November.
+type Rule func(year int) []time.Time
+
+// Calendar models a holiday calendar used to determine business days. A date
+// is a holiday if it is one of the listed holidays or is returned by one of
+// the rules for its year.
+type Calendar struct {
+	Name     string
+	Weekend  []time.Weekday
+	Holidays []time.Time
+	Rules    []Rule
+}
+
+// New returns a new calendar with a Saturday and Sunday weekend.
+func New(name string, holidays []time.Time, rules ...Rule) Calendar {
+	return Calendar{
+		Name:     name,
+		Weekend:  []time.Weekday{time.Saturday, time.Sunday},
+		Holidays: holidays,
+		Rules:    rules,
+	}
+}
+
+// Weekends returns a calendar without holidays, so every weekday is a business
+// day.
+func Weekends() Calendar {
+	return New("Weekends", nil)
+}
+
+// US returns the calendar of US federal holidays as observed by the Federal
+// Reserve Banks. Holidays falling on a Sunday are observed the following
+// Monday, while holidays falling on a Saturday are not observed.
+func US() Calendar {
+	return New("US", nil,
+		Observed(FixedDate(time.January, 1)),
+		Starting(1986, NthWeekday(3, time.Monday, time.January)),
+		NthWeekday(3, time.Monday, time.February),
+		NthWeekday(-1, time.Monday, time.May),
+		Starting(2022, Observed(FixedDate(time.June, 19))),
+		Observed(FixedDate(time.July, 4)),
+		NthWeekday(1, time.Monday, time.September),
+		NthWeekday(2, time.Monday, time.October),
+		Observed(FixedDate(time.November, 11)),
+		NthWeekday(4, time.Thursday, time.November),
+		Observed(FixedDate(time.December, 25)),
+	)
+}
+
+// TARGET returns the calendar for the Trans-European Automated Real-time Gross
+// settlement Express Transfer (TARGET2) system used for euro settlement.
+func TARGET() Calendar {
+	return New("TARGET", nil,
+		FixedDate(time.January, 1),
+		Starting(2000, EasterOffset(-2)),
+		Starting(2000, EasterOffset(1)),
+		Starting(2000, FixedDate(time.May, 1)),
+		FixedDate(time.December, 25),
+		Starting(2000, FixedDate(time.December, 26)),
+	)
+}
+
+// IsWeekend determines if the date falls on a weekend.
+func (c Calendar) IsWeekend(t time.Time) bool {
+	for _, day := range c.Weekend {
+		if t.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHoliday determines if the date is a holiday.
+func (c Calendar) IsHoliday(t time.Time) bool {
+	for _, holiday := range c.Holidays {
+		if sameDate(t, holiday) {
+			return true
+		}
+	}
+	for _, rule := range c.Rules {
+		for _, holiday := range rule(t.Year()) {
+			if sameDate(t, holiday) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsBusinessDay determines if the date is neither a weekend nor a holiday.
+func (c Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// AddBusinessDays adds the number of business days to the date. A negative
+// number of days moves backward.
+func (c Calendar) AddBusinessDays(t time.Time, days int) time.Time {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsBusinessDay(t) {
+			days--
+		}
+	}
+	return t
+}
+
+// ParseFile parses the JSON calendar file into a Calendar. The file lists the
+// holidays as "YYYY-MM-DD" dates, optionally the weekend days (defaulting to
+// Saturday and Sunday), and optionally a built-in calendar ("US" or "TARGET")
+// whose rules are included.
+func ParseFile(filename string) (Calendar, error) {
+	var c Calendar
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(b, &c)
+	return c, err
+}
+
+// UnmarshalJSON unmarshals the given JSON byte slice into a Calendar.
+func (c *Calendar) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		Name     string   `json:"name"`
+		Base     string   `json:"base"`
+		Weekend  []string `json:"weekend"`
+		Holidays []string `json:"holidays"`
+	}
+	err := json.Unmarshal(b, &aux)
+	if err != nil {
+		return err
+	}
+
+	switch aux.Base {
+	case "":
+		*c = New(aux.Name, nil)
+	case "US":
+		*c = US()
+	case "TARGET":
+		*c = TARGET()
+	default:
+		return fmt.Errorf("unknown base calendar %s", aux.Base)
+	}
+	if aux.Name != "" {
+		c.Name = aux.Name
+	}
+
+	if aux.Weekend != nil {
+		c.Weekend = make([]time.Weekday, len(aux.Weekend))
+		days := make(map[time.Weekday]bool, len(aux.Weekend))
+		for i, day := range aux.Weekend {
+			weekday, err := parseWeekday(day)
+			if err != nil {
+				return err
+			}
+			c.Weekend[i] = weekday
+			days[weekday] = true
+		}
+		// Without any business days, adjusting dates would never finish.
+		if len(days) == 7 {
+			return fmt.Errorf("weekend of calendar %s covers every day of the week", c.Name)
+		}
+	}
+	for _, s := range aux.Holidays {
+		holiday, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			return fmt.Errorf("bad holiday %s in calendar %s: %s", s, c.Name, err)
+		}
+		c.Holidays = append(c.Holidays, holiday)
+	}
+	return nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if d.String() == s {
+			return d, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("bad weekday %s", s)
+}
+
+// FixedDate returns a rule for a holiday falling on the same month and day
+// every year.
+func FixedDate(month time.Month, day int) Rule {
+	return func(year int) []time.Time {
+		return []time.Time{date(year, month, day)}
+	}
+}
+
+// NthWeekday returns a rule for a holiday falling on the nth weekday of the
+// month (e.g., the fourth Thursday of November). If n is negative, the
+// holiday is counted from the end of the month (e.g., -1 for the last Monday
+// of May).
+func NthWeekday(n int, weekday time.Weekday, month time.Month) Rule {
+	return func(year int) []time.Time {
+		if n < 0 {
+			last := date(year, month+1, 0)
+			offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+			return []time.Time{last.AddDate(0, 0, -offset+7*(n+1))}
+		}
+		first := date(year, month, 1)
+		offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+		return []time.Time{first.AddDate(0, 0, offset+7*(n-1))}
+	}
+}
+
+// EasterOffset returns a rule for a holiday falling the given number of days
+// from Easter Sunday (e.g., -2 for Good Friday and 1 for Easter Monday).
+func EasterOffset(days int) Rule {
+	return func(year int) []time.Time {
+		return []time.Time{Easter(year).AddDate(0, 0, days)}
+	}
+}
+
+// Observed returns a rule that moves holidays falling on a Sunday to the
+// following Monday.
+func Observed(rule Rule) Rule {
+	return func(year int) []time.Time {
+		var holidays []time.Time
+		for _, holiday := range rule(year) {
+			if holiday.Weekday() == time.Sunday {
+				holiday = holiday.AddDate(0, 0, 1)
+			}
+			holidays = append(holidays, holiday)
+		}
+		return holidays
+	}
+}
+
+// Starting returns a rule that only applies from the given year onward.
+func Starting(first int, rule Rule) Rule {
+	return func(year int) []time.Time {
+		if year < first {
+			return nil
+		}
+		return rule(year)
+	}
+}
+
+// Easter calculates the date of Easter Sunday in the Gregorian calendar using
+// the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func Easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func sameDate(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package calendar
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestHolidays(t *testing.T) {
+	testCases := []struct {
+		calendar Calendar
+		year     int
+		expected []time.Time
+	}{
+		{
+			US(),
+			2024,
+			[]time.Time{
+				date(2024, 1, 1), date(2024, 1, 15), date(2024, 2, 19), date(2024, 5, 27),
+				date(2024, 6, 19), date(2024, 7, 4), date(2024, 9, 2), date(2024, 10, 14),
+				date(2024, 11, 11), date(2024, 11, 28), date(2024, 12, 25),
+			},
+		},
+		{
+			// New Year's Day on a Sunday is observed on Monday.
+			US(),
+			2023,
+			[]time.Time{
+				date(2023, 1, 2), date(2023, 1, 16), date(2023, 2, 20), date(2023, 5, 29),
+				date(2023, 6, 19), date(2023, 7, 4), date(2023, 9, 4), date(2023, 10, 9),
+				date(2023, 11, 11), date(2023, 11, 23), date(2023, 12, 25),
+			},
+		},
+		{
+			TARGET(),
+			2024,
+			[]time.Time{
+				date(2024, 1, 1), date(2024, 3, 29), date(2024, 4, 1),
+				date(2024, 5, 1), date(2024, 12, 25), date(2024, 12, 26),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		expected := make(map[time.Time]bool)
+		for _, holiday := range tc.expected {
+			expected[holiday] = true
+		}
+		for d := date(tc.year, 1, 1); d.Year() == tc.year; d = d.AddDate(0, 0, 1) {
+			if got := tc.calendar.IsHoliday(d); got != expected[d] {
+				t.Errorf("%s holiday on %s = %t, expected = %t", tc.calendar.Name, d.Format("2006-01-02"), got, expected[d])
+			}
+		}
+	}
+	// Independence Day on a Saturday isn't observed on Friday.
+	if !US().IsBusinessDay(date(2020, 7, 3)) {
+		t.Errorf("expected Friday, July 3, 2020 to be a US business day")
+	}
+}
+
+func TestEaster(t *testing.T) {
+	testCases := []time.Time{
+		date(2000, 4, 23),
+		date(2019, 4, 21),
+		date(2024, 3, 31),
+		date(2025, 4, 20),
+		date(2038, 4, 25),
+	}
+	for _, expected := range testCases {
+		if got := Easter(expected.Year()); !got.Equal(expected) {
+			t.Errorf("Easter %d = %s, expected = %s", expected.Year(), got, expected)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	us := US()
+	testCases := []struct {
+		given    time.Time
+		days     int
+		expected time.Time
+	}{
+		{date(2024, 7, 3), 1, date(2024, 7, 5)},
+		{date(2024, 7, 5), -1, date(2024, 7, 3)},
+		{date(2024, 11, 27), 2, date(2024, 12, 2)},
+		{date(2024, 11, 27), 0, date(2024, 11, 27)},
+	}
+	for _, tc := range testCases {
+		if got := us.AddBusinessDays(tc.given, tc.days); !got.Equal(tc.expected) {
+			t.Errorf("%s plus %d business days = %s, expected = %s", tc.given, tc.days, got, tc.expected)
+		}
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	c, err := ParseFile("testdata/holidays.json")
+	if err != nil {
+		t.Fatalf("error parsing calendar file: %s", err)
+	}
+	if c.Name != "Company" {
+		t.Errorf("name = %s, expected = Company", c.Name)
+	}
+	testCases := []struct {
+		date     time.Time
+		expected bool
+	}{
+		{date(2024, 12, 24), false}, // Listed holiday
+		{date(2024, 12, 25), false}, // US rule holiday
+		{date(2024, 12, 27), false}, // Friday weekend
+		{date(2024, 12, 29), true},  // Sunday workday
+		{date(2024, 12, 30), true},
+	}
+	for _, tc := range testCases {
+		if got := c.IsBusinessDay(tc.date); got != tc.expected {
+			t.Errorf("business day on %s = %t, expected = %t", tc.date.Format("2006-01-02"), got, tc.expected)
+		}
+	}
+	if _, err := ParseFile("testdata/missing.json"); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
+
+func TestCalendarUnmarshalJSONErrors(t *testing.T) {
+	testCases := [][]byte{
+		[]byte(`{"name":"Bad","base":"UK"}`),
+		[]byte(`{"name":"Bad","weekend":["Funday"]}`),
+		[]byte(`{"name":"Bad","holidays":["12/25/2024"]}`),
+		[]byte(`{"name":"Bad","weekend":["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"]}`),
+	}
+	for _, data := range testCases {
+		var c Calendar
+		if err := json.Unmarshal(data, &c); err == nil {
+			t.Errorf("expected an error unmarshaling %s", data)
+		}
+	}
+
+	// Six weekend days, even with repeats, leave a business day.
+	data := []byte(`{"name":"Sparse","weekend":["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Friday"]}`)
+	var c Calendar
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if got := c.AddBusinessDays(date(2024, 1, 1), 1); got.Weekday() != time.Saturday {
+		t.Errorf("next business day = %s, expected a Saturday", got.Weekday())
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goinvest/fin/daycount"
+)
+
+// Stub determines where an irregular period is placed when the schedule
+// doesn't divide evenly into regular periods.
+type Stub int
+
+// Stub types. Front stubs generate the regular dates backward from the end
+// date, while back stubs generate them forward from the start date. A long
+// stub combines the irregular period with the adjacent regular period.
+const (
+	ShortFront Stub = 1
+	LongFront  Stub = 2
+	ShortBack  Stub = 3
+	LongBack   Stub = 4
+)
+
+// Schedule models the information needed to generate a payment schedule. The
+// Months is the number of months in each regular period (e.g., 1 for monthly,
+// 3 for quarterly). If EndOfMonth is true and the anchor date (the end date for
+// front stubs or the start date for back stubs) is the last day of its month,
+// every regular date falls on the last day of its month. The payment dates are
+// adjusted using the Convention and Calendar, where the zero value Calendar
+// uses a Saturday and Sunday weekend without holidays.
+type Schedule struct {
+	Start      time.Time
+	End        time.Time
+	Months     int
+	Stub       Stub
+	EndOfMonth bool
+	Convention Convention
+	Calendar   Calendar
+}
+
+// Period contains the unadjusted accrual start and end dates and the adjusted
+// payment date for a single period. Periods are numbered starting at 1 to
+// match the period numbering of the cashflows, where period 0 is the start
+// date.
+type Period struct {
+	Number  int
+	Start   time.Time
+	End     time.Time
+	Payment time.Time
+	Stub    bool
+}
+
+// Generate generates the periods of the schedule.
+func (s Schedule) Generate() ([]Period, error) {
+	if !s.End.After(s.Start) {
+		return nil, fmt.Errorf("schedule end %s must be after start %s",
+			s.End.Format("2006-01-02"), s.Start.Format("2006-01-02"))
+	}
+	if s.Months < 1 {
+		return nil, fmt.Errorf("schedule needs at least one month per period")
+	}
+	stub := s.Stub
+	if stub == 0 {
+		stub = ShortFront
+	}
+	cal := s.Calendar
+	if cal.Name == "" && cal.Weekend == nil && cal.Holidays == nil && cal.Rules == nil {
+		cal = Weekends()
+	}
+	convention := s.Convention
+	if convention == 0 {
+		convention = Unadjusted
+	}
+
+	var dates []time.Time
+	isStub := false
+	switch stub {
+	case ShortFront, LongFront:
+		// Roll backward from the end date.
+		dates = []time.Time{s.End}
+		for n := 1; ; n++ {
+			d := s.roll(s.End, -n*s.Months)
+			if !d.After(s.Start) {
+				isStub = d.Before(s.Start)
+				break
+			}
+			dates = append([]time.Time{d}, dates...)
+		}
+		if isStub && stub == LongFront && len(dates) > 1 {
+			dates = dates[1:]
+		}
+		dates = append([]time.Time{s.Start}, dates...)
+	case ShortBack, LongBack:
+		// Roll forward from the start date.
+		dates = []time.Time{s.Start}
+		for n := 1; ; n++ {
+			d := s.roll(s.Start, n*s.Months)
+			if !d.Before(s.End) {
+				isStub = d.After(s.End)
+				break
+			}
+			dates = append(dates, d)
+		}
+		if isStub && stub == LongBack && len(dates) > 1 {
+			dates = dates[:len(dates)-1]
+		}
+		dates = append(dates, s.End)
+	default:
+		return nil, fmt.Errorf("unknown stub type %d", stub)
+	}
+
+	periods := make([]Period, len(dates)-1)
+	for i := range periods {
+		periods[i] = Period{
+			Number:  i + 1,
+			Start:   dates[i],
+			End:     dates[i+1],
+			Payment: cal.Adjust(dates[i+1], convention),
+		}
+	}
+	if isStub {
+		switch stub {
+		case ShortFront, LongFront:
+			periods[0].Stub = true
+		case ShortBack, LongBack:
+			periods[len(periods)-1].Stub = true
+		}
+	}
+	return periods, nil
+}
+
+// roll adds the number of months to the anchor date applying the end-of-month
+// rule.
+func (s Schedule) roll(anchor time.Time, months int) time.Time {
+	d := daycount.AddMonths(anchor, months)
+	if s.EndOfMonth && isLastDayOfMonth(anchor) {
+		d = date(d.Year(), d.Month()+1, 0)
+	}
+	return d
+}
+
+// PaymentDates returns the adjusted payment date of each period.
+func PaymentDates(periods []Period) []time.Time {
+	dates := make([]time.Time, len(periods))
+	for i, period := range periods {
+		dates[i] = period.Payment
+	}
+	return dates
+}
+
+func isLastDayOfMonth(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleGenerate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule Schedule
+		ends     []time.Time
+		stub     int // Number of the stub period or zero
+	}{
+		{
+			"short_front",
+			Schedule{Start: date(2024, 1, 15), End: date(2024, 12, 31), Months: 3, EndOfMonth: true},
+			[]time.Time{date(2024, 3, 31), date(2024, 6, 30), date(2024, 9, 30), date(2024, 12, 31)},
+			1,
+		},
+		{
+			"long_front",
+			Schedule{Start: date(2024, 1, 15), End: date(2024, 12, 31), Months: 3, Stub: LongFront, EndOfMonth: true},
+			[]time.Time{date(2024, 6, 30), date(2024, 9, 30), date(2024, 12, 31)},
+			1,
+		},
+		{
+			"short_back",
+			Schedule{Start: date(2024, 1, 15), End: date(2024, 12, 31), Months: 3, Stub: ShortBack},
+			[]time.Time{date(2024, 4, 15), date(2024, 7, 15), date(2024, 10, 15), date(2024, 12, 31)},
+			4,
+		},
+		{
+			"long_back",
+			Schedule{Start: date(2024, 1, 15), End: date(2024, 12, 31), Months: 3, Stub: LongBack},
+			[]time.Time{date(2024, 4, 15), date(2024, 7, 15), date(2024, 12, 31)},
+			3,
+		},
+		{
+			"end_of_month",
+			Schedule{Start: date(2023, 8, 31), End: date(2024, 2, 29), Months: 1, EndOfMonth: true},
+			[]time.Time{date(2023, 9, 30), date(2023, 10, 31), date(2023, 11, 30), date(2023, 12, 31), date(2024, 1, 31), date(2024, 2, 29)},
+			0,
+		},
+		{
+			"no_end_of_month",
+			Schedule{Start: date(2023, 8, 31), End: date(2024, 2, 29), Months: 1},
+			[]time.Time{date(2023, 9, 29), date(2023, 10, 29), date(2023, 11, 29), date(2023, 12, 29), date(2024, 1, 29), date(2024, 2, 29)},
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		periods, err := tc.schedule.Generate()
+		if err != nil {
+			t.Errorf("%s: expected no error, got: %s", tc.name, err)
+			continue
+		}
+		if len(periods) != len(tc.ends) {
+			t.Errorf("%s: number of periods = %d, expected = %d", tc.name, len(periods), len(tc.ends))
+			continue
+		}
+		start := tc.schedule.Start
+		for i, period := range periods {
+			if period.Number != i+1 || !period.Start.Equal(start) || !period.End.Equal(tc.ends[i]) {
+				t.Errorf("%s: period %d = %d %s to %s, expected %s to %s", tc.name, i, period.Number,
+					period.Start.Format("2006-01-02"), period.End.Format("2006-01-02"),
+					start.Format("2006-01-02"), tc.ends[i].Format("2006-01-02"))
+			}
+			if period.Stub != (period.Number == tc.stub) {
+				t.Errorf("%s: period %d stub = %t", tc.name, period.Number, period.Stub)
+			}
+			start = period.End
+		}
+	}
+}
+
+func TestSchedulePayments(t *testing.T) {
+	s := Schedule{
+		Start:      date(2024, 1, 15),
+		End:        date(2024, 12, 31),
+		Months:     3,
+		EndOfMonth: true,
+		Convention: ModifiedFollowing,
+		Calendar:   US(),
+	}
+	periods, err := s.Generate()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	expected := []time.Time{date(2024, 3, 29), date(2024, 6, 28), date(2024, 9, 30), date(2024, 12, 31)}
+	for i, payment := range PaymentDates(periods) {
+		if !payment.Equal(expected[i]) {
+			t.Errorf("payment %d = %s, expected = %s", i+1, payment.Format("2006-01-02"), expected[i].Format("2006-01-02"))
+		}
+	}
+}
+
+func TestScheduleErrors(t *testing.T) {
+	testCases := []Schedule{
+		{Start: date(2024, 1, 1), End: date(2024, 1, 1), Months: 1},
+		{Start: date(2024, 1, 1), End: date(2024, 12, 31)},
+		{Start: date(2024, 1, 1), End: date(2024, 12, 31), Months: 1, Stub: Stub(9)},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
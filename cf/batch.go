@@ -0,0 +1,145 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// BatchOptions models the options for evaluating a batch of projects. The
+// Rate is the discount rate (k) used for the NPV, the MIRR, and the discounted
+// payback period. If Workers is zero, one worker is used per CPU.
+type BatchOptions struct {
+	Rate       float64
+	Workers    int
+	IRROptions IRROptions
+}
+
+// BatchResult contains the capital budgeting metrics for a single project. Err
+// is the error from calculating the IRR, in which case the IRR is NaN, or the
+// context's error if the project was not evaluated, in which case all of the
+// metrics are NaN.
+type BatchResult struct {
+	NPV               float64
+	IRR               float64
+	MIRR              float64
+	Payback           float64
+	DiscountedPayback float64
+	Err               error
+}
+
+// Batch evaluates the NPV, IRR, MIRR, payback period, and discounted payback
+// period for each project's cashflows concurrently using a pool of workers.
+// The results are returned in the same order as the projects. The discount
+// factors are calculated once and shared by all projects. If the context is
+// canceled, Batch stops evaluating projects and returns the context's error
+// along with the results, where the projects that were not evaluated have the
+// context's error as their Err.
+func Batch(ctx context.Context, projects [][]float64, opts BatchOptions) ([]BatchResult, error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	maxLen := 0
+	for _, cashflows := range projects {
+		if len(cashflows) > maxLen {
+			maxLen = len(cashflows)
+		}
+	}
+	factors := DiscountFactors(opts.Rate, maxLen)
+
+	results := make([]BatchResult, len(projects))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = evaluate(projects[i], factors, opts.IRROptions)
+			}
+		}()
+	}
+
+	var err error
+	sent := 0
+loop:
+	for i := range projects {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break loop
+		case jobs <- i:
+			sent++
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	// The projects are sent in order, so every project after the last one sent
+	// was skipped.
+	nan := math.NaN()
+	for i := sent; i < len(projects); i++ {
+		results[i] = BatchResult{nan, nan, nan, nan, nan, err}
+	}
+	return results, err
+}
+
+// DiscountFactors calculates the discount factors 1 / (1+k)^t for periods 0
+// through n-1 using the discount rate (k). Each factor is calculated from the
+// previous factor instead of calling math.Pow for each period.
+func DiscountFactors(k float64, n int) []float64 {
+	factors := make([]float64, n)
+	factor := 1.0
+	for t := range factors {
+		factors[t] = factor
+		factor /= 1 + k
+	}
+	return factors
+}
+
+// evaluate calculates the capital budgeting metrics for the cashflows using
+// the precomputed discount factors.
+func evaluate(cashflows, factors []float64, opts IRROptions) BatchResult {
+	irr, err := IRR(cashflows, opts)
+	npv, pvCosts, pvInflows := 0.0, 0.0, 0.0
+	discountedPayback := math.NaN()
+	for t, cf := range cashflows {
+		discountedCF := cf * factors[t]
+		if math.IsNaN(discountedPayback) && npv+discountedCF >= 0.0 {
+			discountedPayback = float64(t-1) - npv/discountedCF
+		}
+		npv += discountedCF
+		if cf > 0 {
+			pvInflows += discountedCF
+		} else {
+			pvCosts -= discountedCF
+		}
+	}
+
+	// The terminal value of the inflows compounded at k is the present value
+	// of the inflows divided by the discount factor for the last period.
+	mirr := math.NaN()
+	if n := len(cashflows) - 1; n > 0 {
+		tv := pvInflows / factors[n]
+		mirr = math.Pow(tv/pvCosts, 1/float64(n)) - 1
+	}
+
+	return BatchResult{
+		NPV:               npv,
+		IRR:               irr,
+		MIRR:              mirr,
+		Payback:           PaybackPeriod(cashflows),
+		DiscountedPayback: discountedPayback,
+		Err:               err,
+	}
+}
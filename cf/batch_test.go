@@ -0,0 +1,122 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	projects := [][]float64{
+		{-1000, 500, 400, 300, 100},
+		{-1000, 100, 300, 400, 600},
+		{1000, 100, 300, 400, 600},
+		{-3000, 1300, 1300, 1300},
+		{-1000, -100, -300, -400, -600},
+	}
+	k := 0.10
+	for _, workers := range []int{0, 1, 3} {
+		results, err := Batch(context.Background(), projects, BatchOptions{Rate: k, Workers: workers})
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err)
+		}
+		if len(results) != len(projects) {
+			t.Fatalf("number of results = %d, expected = %d", len(results), len(projects))
+		}
+		for i, cashflows := range projects {
+			got := results[i]
+			irr, irrErr := IRR(cashflows)
+			if (irrErr == nil) != (got.Err == nil) {
+				t.Errorf("project %d: IRR error = %v, expected = %v", i, got.Err, irrErr)
+			}
+			assertSame(t, "NPV", i, got.NPV, NPV(cashflows, k))
+			assertSame(t, "IRR", i, got.IRR, irr)
+			assertSame(t, "MIRR", i, got.MIRR, MIRR(cashflows, k))
+			assertSame(t, "payback", i, got.Payback, PaybackPeriod(cashflows))
+			assertSame(t, "discounted payback", i, got.DiscountedPayback, DiscountedPaybackPeriod(cashflows, k))
+		}
+	}
+}
+
+func TestBatchCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	projects := [][]float64{{-1000, 500, 400, 300, 100}}
+	if _, err := Batch(ctx, projects, BatchOptions{Rate: 0.10}); err != context.Canceled {
+		t.Errorf("error = %v, expected = %v", err, context.Canceled)
+	}
+}
+
+// cancelAfter is a context that is canceled the n-th time its error is
+// checked.
+type cancelAfter struct {
+	context.Context
+	cancel context.CancelFunc
+	n      int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.n--; c.n == 0 {
+		c.cancel()
+	}
+	return c.Context.Err()
+}
+
+func TestBatchCanceledPartway(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx := &cancelAfter{parent, cancel, 1001}
+	projects := make([][]float64, 100000)
+	for i := range projects {
+		projects[i] = []float64{-1000, 500, 400, 300, 100}
+	}
+
+	results, err := Batch(ctx, projects, BatchOptions{Rate: 0.10, Workers: 4})
+	if err != context.Canceled {
+		t.Fatalf("error = %v, expected = %v", err, context.Canceled)
+	}
+	if len(results) != len(projects) {
+		t.Fatalf("number of results = %d, expected = %d", len(results), len(projects))
+	}
+	npv := NPV(projects[0], 0.10)
+	for i, r := range results {
+		if i < 1000 {
+			if r.Err != nil || !almostEqual(r.NPV, npv) {
+				t.Fatalf("project %d: NPV = %f, error = %v, expected = %f", i, r.NPV, r.Err, npv)
+			}
+			continue
+		}
+		if r.Err != context.Canceled || !math.IsNaN(r.NPV) || !math.IsNaN(r.IRR) {
+			t.Fatalf("project %d: NPV = %f, IRR = %f, error = %v, expected NaN and %v",
+				i, r.NPV, r.IRR, r.Err, context.Canceled)
+		}
+	}
+}
+
+func TestDiscountFactors(t *testing.T) {
+	factors := DiscountFactors(0.10, 4)
+	for i, factor := range factors {
+		expected := 1 / math.Pow(1.10, float64(i))
+		if !almostEqual(expected, factor) {
+			t.Errorf("discount factor %d = %f, expected = %f", i, factor, expected)
+		}
+	}
+}
+
+func assertSame(t *testing.T, label string, i int, got, expected float64) {
+	t.Helper()
+	if math.IsNaN(expected) || math.IsInf(expected, 0) {
+		if math.IsNaN(expected) != math.IsNaN(got) || math.IsInf(expected, 1) != math.IsInf(got, 1) {
+			t.Errorf("project %d: %s = %f, expected = %f", i, label, got, expected)
+		}
+		return
+	}
+	if !almostEqual(expected, got) {
+		t.Errorf("project %d: %s = %f, expected = %f", i, label, got, expected)
+	}
+}
@@ -8,15 +8,22 @@ package cf
 import (
 	"fmt"
 	"math"
+
+	"github.com/goinvest/fin/goalseek"
 )
 
+// ToleranceType determines whether the IRR tolerance is compared against the
+// absolute or relative change in the rate between iterations. The values
+// match the goalseek.ToleranceType values.
 type ToleranceType int
 
+// Tolerance types.
 const (
 	Relative ToleranceType = 1
 	Absolute ToleranceType = 2
 )
 
+// IRROptions models the options for calculating the IRR.
 type IRROptions struct {
 	InitialGuess  float64
 	Tolerance     float64
@@ -34,7 +41,8 @@ type IRROptions struct {
 //
 // If the IRR function is called without the optional struct, the defaults will
 // be initialGuess = 0.1, tolerance = 1e-8, toleranceType = Absolute, and
-// maxIterations = 100.
+// maxIterations = 100. The IRR uses the root-finding machinery in the goalseek
+// package, which can be used to solve other financial models.
 func IRR(cashflows []float64, opts ...IRROptions) (float64, error) {
 
 	if len(cashflows) < 2 {
@@ -62,31 +70,23 @@ func IRR(cashflows []float64, opts ...IRROptions) (float64, error) {
 	}
 
 	// Calculate the IRR using the Newton-Raphson method.
-	rate := initialGuess
-	for i := 0; i < maxIterations; i++ {
-		f, fdk := 0.0, 0.0
-		for i, cf := range cashflows {
-			n := float64(i)
-			// rate = k = discount rate, which is the IRR
-			// f (function) = NPV = ∑n=0-N: CF_n / (1+rate)^n
-			// fdk (derivative) = d/dk NPV = ∑n=0-N: -n * CF_n / (1+rate)^(n+1)
-			f += cf / math.Pow(1+rate, n)
-			fdk -= n * cf / math.Pow(1+rate, n+1)
-		}
-		if math.Abs(fdk) < 1e-12 {
-			return math.NaN(), fmt.Errorf("derivative too close to zero, cannot converge")
-		}
-		newRate := rate - (f / fdk)
-
-		if toleranceType == Relative && math.Abs(newRate-rate)/rate < tolerance {
-			return newRate, nil
-		} else if toleranceType == Absolute && math.Abs(newRate-rate) < tolerance {
-			return newRate, nil
-		}
-		rate = newRate
+	// rate = k = discount rate, which is the IRR
+	// f (function) = NPV = ∑n=0-N: CF_n / (1+rate)^n
+	// fdk (derivative) = d/dk NPV = ∑n=0-N: -n * CF_n / (1+rate)^(n+1)
+	// Both are evaluated together in a single pass over the cashflows.
+	fdk := func(rate float64) (float64, float64) {
+		return npvAndDerivative(cashflows, rate)
 	}
-
-	return math.NaN(), fmt.Errorf("failed to converge after %d iterations", maxIterations)
+	result, err := goalseek.RootNewton(fdk, goalseek.Options{
+		InitialGuess:  initialGuess,
+		Tolerance:     tolerance,
+		ToleranceType: goalseek.ToleranceType(toleranceType),
+		MaxIterations: maxIterations,
+	})
+	if err != nil {
+		return math.NaN(), err
+	}
+	return result.X, nil
 }
 
 // MIRR calculates the Modified Internal Rate of Return (MIRR), which is the
@@ -148,14 +148,32 @@ func PaybackPeriod(cashflows []float64) float64 {
 // discount rate (k). The initial cashflow is not discounted.
 //
 // NPV = ∑(CF_t / (1+k)^t) for t=0...n
+//
+// The NPV is evaluated as a polynomial in the discount factor v = 1/(1+k)
+// using Horner's method, which avoids calling math.Pow for each period.
 func NPV(cashflows []float64, k float64) float64 {
+	v := 1 / (1 + k)
 	npv := 0.0
-	for i, cf := range cashflows {
-		npv += cf / math.Pow(1+k, float64(i))
+	for i := len(cashflows) - 1; i >= 0; i-- {
+		npv = npv*v + cashflows[i]
 	}
 	return npv
 }
 
+// npvAndDerivative calculates the NPV and its derivative with respect to the
+// discount rate (k) in a single pass using Horner's method. With the discount
+// factor v = 1/(1+k), the NPV is the polynomial p(v) = ∑(CF_t * v^t) and the
+// derivative is d/dk NPV = p'(v) * dv/dk = -v^2 * p'(v).
+func npvAndDerivative(cashflows []float64, k float64) (float64, float64) {
+	v := 1 / (1 + k)
+	p, dp := 0.0, 0.0
+	for i := len(cashflows) - 1; i >= 0; i-- {
+		dp = dp*v + p
+		p = p*v + cashflows[i]
+	}
+	return p, -v * v * dp
+}
+
 // NCF calcualates the Net Cash Flows (NCF) for the cashflows given per period.
 func NCF(cashflows []float64) float64 {
 	sum := 0.0
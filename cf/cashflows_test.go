@@ -6,6 +6,7 @@
 package cf
 
 import (
+	"fmt"
 	"math"
 	"testing"
 )
@@ -151,6 +152,68 @@ func TestNCF(t *testing.T) {
 	}
 }
 
+func TestNPVMatchesPow(t *testing.T) {
+	rates := []float64{-0.2, -0.01, 0.0, 0.005, 0.10, 2.5}
+	for _, periods := range []int{1, 10, 120, 1200} {
+		cashflows := benchCashflows(periods)
+		for _, k := range rates {
+			npv, dnpv := 0.0, 0.0
+			for i, cf := range cashflows {
+				n := float64(i)
+				npv += cf / math.Pow(1+k, n)
+				dnpv -= n * cf / math.Pow(1+k, n+1)
+			}
+			gotNPV, gotDNPV := npvAndDerivative(cashflows, k)
+			if !relativelyEqual(npv, NPV(cashflows, k)) || !relativelyEqual(npv, gotNPV) {
+				t.Errorf("%d periods at %f: NPV = %g, expected = %g", periods, k, NPV(cashflows, k), npv)
+			}
+			if !relativelyEqual(dnpv, gotDNPV) {
+				t.Errorf("%d periods at %f: dNPV/dk = %g, expected = %g", periods, k, gotDNPV, dnpv)
+			}
+		}
+	}
+}
+
+func BenchmarkNPV(b *testing.B) {
+	for _, periods := range []int{10, 120, 1200} {
+		cashflows := benchCashflows(periods)
+		b.Run(fmt.Sprintf("periods_%d", periods), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				NPV(cashflows, 0.005)
+			}
+		})
+	}
+}
+
+func BenchmarkIRR(b *testing.B) {
+	opts := IRROptions{InitialGuess: 0.005}
+	for _, periods := range []int{10, 120, 1200} {
+		cashflows := benchCashflows(periods)
+		b.Run(fmt.Sprintf("periods_%d", periods), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := IRR(cashflows, opts); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchCashflows returns an initial investment followed by level monthly
+// cashflows that recover the investment with a positive IRR.
+func benchCashflows(periods int) []float64 {
+	cashflows := make([]float64, periods+1)
+	cashflows[0] = -1000
+	for i := 1; i <= periods; i++ {
+		cashflows[i] = 1500 / float64(periods)
+	}
+	return cashflows
+}
+
+func relativelyEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) <= 1e-9*math.Max(1.0, math.Max(math.Abs(f1), math.Abs(f2)))
+}
+
 func almostEqual(f1, f2 float64) bool {
 	return math.Abs(f1-f2) < tolerance
 }
@@ -0,0 +1,85 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/goinvest/fin/daycount"
+	"github.com/goinvest/fin/goalseek"
+)
+
+// DatedCashflow models a cashflow occurring on a specific date instead of in
+// an evenly spaced period.
+type DatedCashflow struct {
+	Date   time.Time
+	Amount float64
+}
+
+// XNPV calculates the Net Present Value (NPV) of the dated cashflows based on
+// the annual discount rate (k). Each cashflow is discounted from its date back
+// to the date of the first cashflow using the ACT/365 Fixed day count.
+//
+// XNPV = ∑(CF_i / (1+k)^((d_i - d_0)/365))
+func XNPV(cashflows []DatedCashflow, k float64) float64 {
+	if len(cashflows) == 0 {
+		return 0.0
+	}
+	npv := 0.0
+	for _, cf := range cashflows {
+		t := daycount.Actual365Fixed{}.YearFraction(cashflows[0].Date, cf.Date)
+		npv += cf.Amount / math.Pow(1+k, t)
+	}
+	return npv
+}
+
+// XIRR calculates the annual Internal Rate of Return (IRR) of the dated
+// cashflows, which is the discount rate for which the XNPV equals zero. The
+// XIRR uses the same options and defaults as the IRR.
+func XIRR(cashflows []DatedCashflow, opts ...IRROptions) (float64, error) {
+	if len(cashflows) < 2 {
+		return math.NaN(), fmt.Errorf("need at least two cash flows")
+	}
+	o := goalseek.Options{
+		Method:        goalseek.Newton,
+		InitialGuess:  0.1,
+		ToleranceType: goalseek.Absolute,
+	}
+	if len(opts) > 0 {
+		if opts[0].InitialGuess != 0.0 {
+			o.InitialGuess = opts[0].InitialGuess
+		}
+		o.Tolerance = opts[0].Tolerance
+		o.ToleranceType = goalseek.ToleranceType(opts[0].ToleranceType)
+		o.MaxIterations = opts[0].MaxIterations
+	}
+
+	times := make([]float64, len(cashflows))
+	for i, cf := range cashflows {
+		times[i] = daycount.Actual365Fixed{}.YearFraction(cashflows[0].Date, cf.Date)
+	}
+	f := func(rate float64) float64 {
+		npv := 0.0
+		for i, cf := range cashflows {
+			npv += cf.Amount / math.Pow(1+rate, times[i])
+		}
+		return npv
+	}
+	fdk := func(rate float64) float64 {
+		d := 0.0
+		for i, cf := range cashflows {
+			d -= times[i] * cf.Amount / math.Pow(1+rate, times[i]+1)
+		}
+		return d
+	}
+	result, err := goalseek.Root(f, fdk, o)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return result.X, nil
+}
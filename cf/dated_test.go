@@ -0,0 +1,58 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func datedCashflows() []DatedCashflow {
+	return []DatedCashflow{
+		{time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC), -10000},
+		{time.Date(2008, 3, 1, 0, 0, 0, 0, time.UTC), 2750},
+		{time.Date(2008, 10, 30, 0, 0, 0, 0, time.UTC), 4250},
+		{time.Date(2009, 2, 15, 0, 0, 0, 0, time.UTC), 3250},
+		{time.Date(2009, 4, 1, 0, 0, 0, 0, time.UTC), 2750},
+	}
+}
+
+func TestXNPV(t *testing.T) {
+	if got := XNPV(datedCashflows(), 0.09); !almostEqual(got, 2086.647602) {
+		t.Errorf("XNPV calculated = %f, expected = %f", got, 2086.647602)
+	}
+	if got := XNPV(nil, 0.09); got != 0.0 {
+		t.Errorf("XNPV of no cashflows = %f, expected = 0", got)
+	}
+}
+
+func TestXIRR(t *testing.T) {
+	testCases := []struct {
+		cashflows []DatedCashflow
+		options   []IRROptions
+		expected  float64
+	}{
+		{datedCashflows(), nil, 0.373363},
+		{datedCashflows(), []IRROptions{{InitialGuess: 0.5, Tolerance: 1e-10}}, 0.373363},
+		{datedCashflows()[:1], nil, math.NaN()},
+	}
+	for _, tc := range testCases {
+		got, err := XIRR(tc.cashflows, tc.options...)
+		if math.IsNaN(tc.expected) {
+			if err == nil {
+				t.Errorf("expected an error, got nil with XIRR = %f", got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("XIRR calculated = %f, expected = %f", got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"fmt"
+	"math"
+)
+
+// NominalRate calculates the nominal rate from the real rate and the inflation
+// rate using the Fisher equation.
+//
+// (1 + nominal) = (1 + real) * (1 + inflation)
+func NominalRate(real, inflation float64) float64 {
+	return (1+real)*(1+inflation) - 1
+}
+
+// RealRate calculates the real rate from the nominal rate and the inflation
+// rate using the Fisher equation.
+//
+// (1 + real) = (1 + nominal) / (1 + inflation)
+func RealRate(nominal, inflation float64) float64 {
+	return (1+nominal)/(1+inflation) - 1
+}
+
+// Inflate converts real cashflows, stated in period 0 currency, into nominal
+// cashflows using a constant inflation rate per period.
+//
+// Nominal CF_t = Real CF_t * (1 + inflation)^t
+func Inflate(cashflows []float64, inflation float64) []float64 {
+	nominal := make([]float64, len(cashflows))
+	for i, cf := range cashflows {
+		nominal[i] = cf * math.Pow(1+inflation, float64(i))
+	}
+	return nominal
+}
+
+// Deflate converts nominal cashflows into real cashflows, stated in period 0
+// currency, using a constant inflation rate per period.
+//
+// Real CF_t = Nominal CF_t / (1 + inflation)^t
+func Deflate(cashflows []float64, inflation float64) []float64 {
+	real := make([]float64, len(cashflows))
+	for i, cf := range cashflows {
+		real[i] = cf / math.Pow(1+inflation, float64(i))
+	}
+	return real
+}
+
+// InflatePath converts real cashflows into nominal cashflows using the
+// inflation rate for each period, where path[t-1] is the inflation during
+// period t. The path must contain at least one less rate than the number of
+// cashflows.
+func InflatePath(cashflows, path []float64) ([]float64, error) {
+	indices, err := priceIndices(len(cashflows), path)
+	if err != nil {
+		return nil, err
+	}
+	nominal := make([]float64, len(cashflows))
+	for i, cf := range cashflows {
+		nominal[i] = cf * indices[i]
+	}
+	return nominal, nil
+}
+
+// DeflatePath converts nominal cashflows into real cashflows using the
+// inflation rate for each period, where path[t-1] is the inflation during
+// period t. The path must contain at least one less rate than the number of
+// cashflows.
+func DeflatePath(cashflows, path []float64) ([]float64, error) {
+	indices, err := priceIndices(len(cashflows), path)
+	if err != nil {
+		return nil, err
+	}
+	real := make([]float64, len(cashflows))
+	for i, cf := range cashflows {
+		real[i] = cf / indices[i]
+	}
+	return real, nil
+}
+
+// priceIndices returns the cumulative price index for n periods starting with
+// an index of 1.0 in period 0.
+func priceIndices(n int, path []float64) ([]float64, error) {
+	if n > 0 && len(path) < n-1 {
+		return nil, fmt.Errorf("need %d inflation rates, got %d", n-1, len(path))
+	}
+	indices := make([]float64, n)
+	index := 1.0
+	for i := range indices {
+		if i > 0 {
+			index *= 1 + path[i-1]
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// RealNominalNPV contains the NPV of a set of cashflows computed both in
+// real terms and in nominal terms. When the cashflows and discount rates are
+// treated consistently the two values are equal.
+type RealNominalNPV struct {
+	Real    float64
+	Nominal float64
+}
+
+// NPVRealNominal calculates the NPV of nominal cashflows both by discounting
+// the nominal cashflows at the nominal discount rate (k) and by discounting
+// the deflated (real) cashflows at the real discount rate found using the
+// Fisher equation.
+func NPVRealNominal(cashflows []float64, k, inflation float64) RealNominalNPV {
+	return RealNominalNPV{
+		Real:    NPV(Deflate(cashflows, inflation), RealRate(k, inflation)),
+		Nominal: NPV(cashflows, k),
+	}
+}
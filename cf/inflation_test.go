@@ -0,0 +1,102 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"testing"
+)
+
+func TestFisherRates(t *testing.T) {
+	testCases := []struct {
+		nominal   float64
+		real      float64
+		inflation float64
+	}{
+		{0.1330, 0.10, 0.03},
+		{0.05, 0.05, 0.0},
+		{0.0, -0.0291262, 0.03},
+	}
+	for _, tc := range testCases {
+		if got := NominalRate(tc.real, tc.inflation); !almostEqual(tc.nominal, got) {
+			t.Errorf("nominal rate calculated = %f, expected = %f", got, tc.nominal)
+		}
+		if got := RealRate(tc.nominal, tc.inflation); !almostEqual(tc.real, got) {
+			t.Errorf("real rate calculated = %f, expected = %f", got, tc.real)
+		}
+	}
+}
+
+func TestInflateDeflate(t *testing.T) {
+	real := []float64{-1000, 300, 400, 500}
+	nominal := []float64{-1000, 309, 424.36, 546.3635}
+	gotNominal := Inflate(real, 0.03)
+	gotReal := Deflate(nominal, 0.03)
+	for i := range real {
+		if !almostEqual(nominal[i], gotNominal[i]) {
+			t.Errorf("inflated cashflow %d = %f, expected = %f", i, gotNominal[i], nominal[i])
+		}
+		if !almostEqual(real[i], gotReal[i]) {
+			t.Errorf("deflated cashflow %d = %f, expected = %f", i, gotReal[i], real[i])
+		}
+	}
+}
+
+func TestInflatePath(t *testing.T) {
+	testCases := []struct {
+		real    []float64
+		path    []float64
+		nominal []float64
+	}{
+		{[]float64{-1000, 300, 400, 500}, []float64{0.03, 0.03, 0.03}, []float64{-1000, 309, 424.36, 546.3635}},
+		{[]float64{100, 100, 100}, []float64{0.10, 0.0}, []float64{100, 110, 110}},
+		{[]float64{100, 100}, []float64{0.05, 0.20, 0.30}, []float64{100, 105}},
+	}
+	for _, tc := range testCases {
+		gotNominal, err := InflatePath(tc.real, tc.path)
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		gotReal, err := DeflatePath(tc.nominal, tc.path)
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		for i := range tc.real {
+			if !almostEqual(tc.nominal[i], gotNominal[i]) {
+				t.Errorf("inflated cashflow %d = %f, expected = %f", i, gotNominal[i], tc.nominal[i])
+			}
+			if !almostEqual(tc.real[i], gotReal[i]) {
+				t.Errorf("deflated cashflow %d = %f, expected = %f", i, gotReal[i], tc.real[i])
+			}
+		}
+	}
+	if _, err := InflatePath([]float64{1, 2, 3}, []float64{0.03}); err == nil {
+		t.Errorf("expected an error for a short inflation path")
+	}
+	if _, err := DeflatePath([]float64{1, 2, 3}, []float64{0.03}); err == nil {
+		t.Errorf("expected an error for a short inflation path")
+	}
+}
+
+func TestNPVRealNominal(t *testing.T) {
+	testCases := []struct {
+		cashflows []float64
+		k         float64
+		inflation float64
+		expected  float64
+	}{
+		{[]float64{-1000, 300, 400, 500}, 0.10, 0.03, -21.036814},
+		{[]float64{-1000, 500, 400, 300, 100}, 0.10, 0.0, 78.819753},
+	}
+	for _, tc := range testCases {
+		got := NPVRealNominal(tc.cashflows, tc.k, tc.inflation)
+		if !almostEqual(tc.expected, got.Nominal) {
+			t.Errorf("nominal NPV calculated = %f, expected = %f", got.Nominal, tc.expected)
+		}
+		if !almostEqual(tc.expected, got.Real) {
+			t.Errorf("real NPV calculated = %f, expected = %f", got.Real, tc.expected)
+		}
+	}
+}
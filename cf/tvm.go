@@ -0,0 +1,69 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"math"
+)
+
+// The time value of money (TVM) functions use the same sign convention as the
+// cashflows: money received is positive and money paid is negative. They
+// satisfy the TVM equation
+//
+// PV * (1+r)^n + PMT * (1 + r*due) * [(1+r)^n - 1] / r + FV = 0
+//
+// where due is 1 if payments are made at the beginning of each period (an
+// annuity due) and 0 if payments are made at the end of each period.
+
+// FV calculates the future value after nper periods at the rate per period
+// given the payment per period and the present value.
+func FV(rate, nper, pmt, pv float64, due bool) float64 {
+	if rate == 0.0 {
+		return -(pv + pmt*nper)
+	}
+	growth := math.Pow(1+rate, nper)
+	return -(pv*growth + pmt*annuityDue(rate, due)*(growth-1)/rate)
+}
+
+// PV calculates the present value of nper payments at the rate per period
+// plus the future value.
+func PV(rate, nper, pmt, fv float64, due bool) float64 {
+	if rate == 0.0 {
+		return -(fv + pmt*nper)
+	}
+	growth := math.Pow(1+rate, nper)
+	return -(fv + pmt*annuityDue(rate, due)*(growth-1)/rate) / growth
+}
+
+// PMT calculates the payment per period needed to amortize the present value
+// to the future value over nper periods at the rate per period. For example,
+// the monthly payment on a $100,000 loan is PMT(0.06/12, 360, 100000, 0,
+// false) = -599.55.
+func PMT(rate, nper, pv, fv float64, due bool) float64 {
+	if rate == 0.0 {
+		return -(pv + fv) / nper
+	}
+	growth := math.Pow(1+rate, nper)
+	return -(pv*growth + fv) * rate / (annuityDue(rate, due) * (growth - 1))
+}
+
+// NPER calculates the number of periods needed to amortize the present value
+// to the future value with the payment at the rate per period. If the payment
+// never amortizes the present value, then NaN is returned.
+func NPER(rate, pmt, pv, fv float64, due bool) float64 {
+	if rate == 0.0 {
+		return -(pv + fv) / pmt
+	}
+	z := pmt * annuityDue(rate, due) / rate
+	return math.Log((z-fv)/(z+pv)) / math.Log(1+rate)
+}
+
+func annuityDue(rate float64, due bool) float64 {
+	if due {
+		return 1 + rate
+	}
+	return 1.0
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package cf
+
+import (
+	"testing"
+)
+
+func TestTVM(t *testing.T) {
+	testCases := []struct {
+		rate float64
+		nper float64
+		pmt  float64
+		pv   float64
+		fv   float64
+		due  bool
+	}{
+		{0.06 / 12, 360, -599.5505251527569, 100000, 0, false},
+		{0.06 / 12, 360, -596.5676867191612, 100000, 0, true},
+		{0.05, 10, -100, -1000, 2886.683880332326, false},
+		{0.0, 10, -100, 500, 500, false},
+	}
+	for _, tc := range testCases {
+		if got := PMT(tc.rate, tc.nper, tc.pv, tc.fv, tc.due); !almostEqual(got, tc.pmt) {
+			t.Errorf("PMT calculated = %f, expected = %f", got, tc.pmt)
+		}
+		if got := PV(tc.rate, tc.nper, tc.pmt, tc.fv, tc.due); !almostEqual(got, tc.pv) {
+			t.Errorf("PV calculated = %f, expected = %f", got, tc.pv)
+		}
+		if got := FV(tc.rate, tc.nper, tc.pmt, tc.pv, tc.due); !almostEqual(got, tc.fv) {
+			t.Errorf("FV calculated = %f, expected = %f", got, tc.fv)
+		}
+		if got := NPER(tc.rate, tc.pmt, tc.pv, tc.fv, tc.due); !almostEqual(got, tc.nper) {
+			t.Errorf("NPER calculated = %f, expected = %f", got, tc.nper)
+		}
+	}
+}
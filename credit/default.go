@@ -0,0 +1,161 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package credit
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// ExpectedLoss calculates the expected loss from the probability of default
+// (PD), the loss given default (LGD) as a fraction of the exposure, and the
+// exposure at default (EAD).
+//
+// EL = PD * LGD * EAD
+func ExpectedLoss(pd, lgd, ead float64) float64 {
+	return pd * lgd * ead
+}
+
+// HazardFromPD calculates the constant hazard rate (i.e., default intensity)
+// implied by the cumulative probability of default over the given number of
+// years.
+//
+// λ = -ln(1 - PD) / years
+func HazardFromPD(pd, years float64) float64 {
+	return -math.Log(1-pd) / years
+}
+
+// CumulativePD calculates the cumulative probability of default over the
+// given number of years from a constant hazard rate.
+//
+// PD = 1 - exp(-λ * years)
+func CumulativePD(hazard, years float64) float64 {
+	return 1 - math.Exp(-hazard*years)
+}
+
+// HazardFromSpread calculates the hazard rate implied by the credit spread
+// and the recovery rate using the credit triangle.
+//
+// λ = spread / (1 - recovery)
+func HazardFromSpread(spread, recovery float64) float64 {
+	return spread / (1 - recovery)
+}
+
+// SpreadFromHazard calculates the credit spread implied by the hazard rate
+// and the recovery rate using the credit triangle.
+//
+// spread = λ * (1 - recovery)
+func SpreadFromHazard(hazard, recovery float64) float64 {
+	return hazard * (1 - recovery)
+}
+
+// Survival returns the survival probabilities for periods 0 through n from a
+// constant hazard rate per period.
+func Survival(hazard float64, n int) []float64 {
+	survival := make([]float64, n+1)
+	for t := range survival {
+		survival[t] = math.Exp(-hazard * float64(t))
+	}
+	return survival
+}
+
+// SurvivalFromPDs returns the survival probabilities for periods 0 through n
+// from the cumulative probabilities of default for periods 1 through n.
+func SurvivalFromPDs(cumulative []float64) ([]float64, error) {
+	survival := make([]float64, len(cumulative)+1)
+	survival[0] = 1.0
+	for t, pd := range cumulative {
+		if pd < 0.0 || pd > 1.0 {
+			return nil, fmt.Errorf("cumulative PD %f in period %d outside of [0, 1]", pd, t+1)
+		}
+		if t > 0 && pd < cumulative[t-1] {
+			return nil, fmt.Errorf("cumulative PD decreases in period %d", t+1)
+		}
+		survival[t+1] = 1 - pd
+	}
+	return survival, nil
+}
+
+// MarginalPDs calculates the marginal (unconditional) probability of default
+// in each of periods 1 through n from the cumulative probabilities of default,
+// which is the probability, as seen today, of defaulting during the period.
+//
+// marginal_t = PD_t - PD_{t-1}
+func MarginalPDs(cumulative []float64) []float64 {
+	marginal := make([]float64, len(cumulative))
+	prev := 0.0
+	for t, pd := range cumulative {
+		marginal[t] = pd - prev
+		prev = pd
+	}
+	return marginal
+}
+
+// ConditionalPDs calculates the conditional probability of default in each
+// of periods 1 through n from the cumulative probabilities of default, which
+// is the probability of defaulting during the period given survival to the
+// start of the period.
+//
+// conditional_t = (PD_t - PD_{t-1}) / (1 - PD_{t-1})
+func ConditionalPDs(cumulative []float64) []float64 {
+	conditional := make([]float64, len(cumulative))
+	prev := 0.0
+	for t, pd := range cumulative {
+		conditional[t] = (pd - prev) / (1 - prev)
+		prev = pd
+	}
+	return conditional
+}
+
+// CumulativePDs calculates the cumulative probabilities of default for
+// periods 1 through n from the marginal (unconditional) probabilities of
+// default.
+func CumulativePDs(marginal []float64) []float64 {
+	cumulative := make([]float64, len(marginal))
+	total := 0.0
+	for t, pd := range marginal {
+		total += pd
+		cumulative[t] = total
+	}
+	return cumulative
+}
+
+// ExpectedCashflows calculates the expected cash flows of risky debt for
+// periods 0 through n. The promised cash flow in each period is received if
+// the borrower survives, and the recovery rate times the exposure is received
+// if the borrower defaults during the period. The survival probabilities are
+// for periods 0 through n, and the exposure in period t is the exposure at
+// default during period t (e.g., the par value of a bond).
+//
+// E[CF_t] = S_t * CF_t + R * EAD_t * (S_{t-1} - S_t)
+func ExpectedCashflows(promised, survival, exposure []float64, recovery float64) ([]float64, error) {
+	if len(survival) != len(promised) {
+		return nil, fmt.Errorf("have %d survival probabilities for %d cashflows", len(survival), len(promised))
+	}
+	if len(exposure) != len(promised) {
+		return nil, fmt.Errorf("have %d exposures for %d cashflows", len(exposure), len(promised))
+	}
+	expected := make([]float64, len(promised))
+	for t, cashflow := range promised {
+		expected[t] = survival[t] * cashflow
+		if t > 0 {
+			expected[t] += recovery * exposure[t] * (survival[t-1] - survival[t])
+		}
+	}
+	return expected, nil
+}
+
+// RiskyValue calculates the value of risky debt by discounting the expected
+// cash flows at the risk-free discount rate (k).
+func RiskyValue(promised, survival, exposure []float64, recovery, k float64) (float64, error) {
+	expected, err := ExpectedCashflows(promised, survival, exposure, recovery)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return cf.NPV(expected, k), nil
+}
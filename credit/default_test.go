@@ -0,0 +1,137 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package credit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goinvest/fin/cf"
+)
+
+const tolerance = 0.000001
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) <= tolerance
+}
+
+func TestExpectedLoss(t *testing.T) {
+	testCases := []struct {
+		pd       float64
+		lgd      float64
+		ead      float64
+		expected float64
+	}{
+		{0.02, 0.45, 1000000, 9000},
+		{0.0, 0.45, 1000000, 0},
+		{1.0, 0.60, 500, 300},
+	}
+	for _, tc := range testCases {
+		if got := ExpectedLoss(tc.pd, tc.lgd, tc.ead); !almostEqual(got, tc.expected) {
+			t.Errorf("expected loss calculated = %f, expected = %f", got, tc.expected)
+		}
+	}
+}
+
+func TestHazardConversions(t *testing.T) {
+	testCases := []struct {
+		pd     float64
+		years  float64
+		hazard float64
+	}{
+		{0.05, 1, 0.051293},
+		{0.095163, 5, 0.02},
+		{0.0, 3, 0.0},
+	}
+	for _, tc := range testCases {
+		if got := HazardFromPD(tc.pd, tc.years); !almostEqual(got, tc.hazard) {
+			t.Errorf("hazard calculated = %f, expected = %f", got, tc.hazard)
+		}
+		if got := CumulativePD(tc.hazard, tc.years); math.Abs(got-tc.pd) > 0.000001 {
+			t.Errorf("cumulative PD calculated = %f, expected = %f", got, tc.pd)
+		}
+	}
+
+	if got := HazardFromSpread(0.012, 0.40); !almostEqual(got, 0.02) {
+		t.Errorf("hazard from spread calculated = %f, expected = %f", got, 0.02)
+	}
+	if got := SpreadFromHazard(0.02, 0.40); !almostEqual(got, 0.012) {
+		t.Errorf("spread from hazard calculated = %f, expected = %f", got, 0.012)
+	}
+}
+
+func TestPDTermStructure(t *testing.T) {
+	cumulative := []float64{0.01, 0.025, 0.045}
+	marginal := []float64{0.01, 0.015, 0.02}
+	conditional := []float64{0.01, 0.015152, 0.020513}
+	survival := []float64{1, 0.99, 0.975, 0.955}
+
+	gotMarginal := MarginalPDs(cumulative)
+	gotConditional := ConditionalPDs(cumulative)
+	gotCumulative := CumulativePDs(marginal)
+	gotSurvival, err := SurvivalFromPDs(cumulative)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range cumulative {
+		if !almostEqual(gotMarginal[i], marginal[i]) {
+			t.Errorf("marginal PD %d = %f, expected = %f", i, gotMarginal[i], marginal[i])
+		}
+		if math.Abs(gotConditional[i]-conditional[i]) > 0.000001 {
+			t.Errorf("conditional PD %d = %f, expected = %f", i, gotConditional[i], conditional[i])
+		}
+		if !almostEqual(gotCumulative[i], cumulative[i]) {
+			t.Errorf("cumulative PD %d = %f, expected = %f", i, gotCumulative[i], cumulative[i])
+		}
+	}
+	for i := range survival {
+		if !almostEqual(gotSurvival[i], survival[i]) {
+			t.Errorf("survival %d = %f, expected = %f", i, gotSurvival[i], survival[i])
+		}
+	}
+
+	for _, pds := range [][]float64{{0.01, 1.5}, {0.03, 0.02}} {
+		if _, err := SurvivalFromPDs(pds); err == nil {
+			t.Errorf("expected an error for cumulative PDs %v", pds)
+		}
+	}
+}
+
+func TestRiskyValue(t *testing.T) {
+	promised := []float64{0, 5, 5, 105}
+	exposure := []float64{0, 100, 100, 100}
+	testCases := []struct {
+		name     string
+		promised []float64
+		survival []float64
+		exposure []float64
+		recovery float64
+		k        float64
+		expected float64
+	}{
+		{"hazard", promised, Survival(0.02, 3), exposure, 0.40, 0.03, 101.977668},
+		{"default_free", promised, Survival(0.0, 3), exposure, 0.40, 0.03, cf.NPV(promised, 0.03)},
+		// Recovering the par value and coupon on default makes a par bond
+		// worth par at any hazard rate.
+		{"full_recovery", []float64{0, 8, 8, 108}, Survival(0.10, 3), []float64{0, 108, 108, 108}, 1.0, 0.08, 100},
+	}
+	for _, tc := range testCases {
+		got, err := RiskyValue(tc.promised, tc.survival, tc.exposure, tc.recovery, tc.k)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("%s: risky value = %f, expected = %f", tc.name, got, tc.expected)
+		}
+	}
+
+	if got, err := RiskyValue(promised, Survival(0.02, 2), exposure, 0.40, 0.03); err == nil || !math.IsNaN(got) {
+		t.Errorf("risky value = %f, expected NaN and an error for mismatched survival probabilities", got)
+	}
+	if _, err := RiskyValue(promised, Survival(0.02, 3), exposure[:2], 0.40, 0.03); err == nil {
+		t.Errorf("expected an error for mismatched exposures")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package credit
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin"
+)
+
+// Rating maps an interest coverage ratio of at least MinCoverage to a credit
+// rating and its default spread over the risk-free rate.
+type Rating struct {
+	MinCoverage float64
+	Rating      string
+	Spread      float64
+}
+
+// RatingTable maps interest coverage ratios to synthetic ratings. The ratings
+// must be in descending order of MinCoverage.
+type RatingTable []Rating
+
+// ratingSpreads are representative default spreads for each rating. Spreads
+// change with market conditions, so callers needing current spreads should
+// build their own RatingTable.
+var ratingSpreads = []struct {
+	rating string
+	spread float64
+}{
+	{"AAA", 0.0059},
+	{"AA", 0.0070},
+	{"A+", 0.0092},
+	{"A", 0.0107},
+	{"A-", 0.0121},
+	{"BBB", 0.0147},
+	{"BB+", 0.0174},
+	{"BB", 0.0221},
+	{"B+", 0.0280},
+	{"B", 0.0338},
+	{"B-", 0.0490},
+	{"CCC", 0.0593},
+	{"CC", 0.0842},
+	{"C", 0.1147},
+	{"D", 0.1500},
+}
+
+// newRatingTable builds a rating table from the minimum coverage for each of
+// the ratings in ratingSpreads.
+func newRatingTable(minCoverage []float64) RatingTable {
+	table := make(RatingTable, len(ratingSpreads))
+	for i, rs := range ratingSpreads {
+		table[i] = Rating{minCoverage[i], rs.rating, rs.spread}
+	}
+	return table
+}
+
+// LargeCap returns the rating table for large, stable firms using the
+// coverage ratio thresholds popularized by Aswath Damodaran for firms with a
+// market capitalization above $5 billion.
+func LargeCap() RatingTable {
+	return newRatingTable([]float64{
+		8.5, 6.5, 5.5, 4.25, 3.0, 2.5, 2.25, 2.0, 1.75, 1.5, 1.25, 0.8, 0.65, 0.2, math.Inf(-1),
+	})
+}
+
+// SmallCap returns the rating table for smaller, riskier firms using the
+// coverage ratio thresholds popularized by Aswath Damodaran for firms with a
+// market capitalization below $5 billion.
+func SmallCap() RatingTable {
+	return newRatingTable([]float64{
+		12.5, 9.5, 7.5, 6.0, 4.5, 4.0, 3.5, 3.0, 2.5, 2.0, 1.5, 1.25, 0.8, 0.5, math.Inf(-1),
+	})
+}
+
+// Lookup returns the rating for the interest coverage ratio, which is the
+// first rating whose minimum coverage the ratio meets.
+func (t RatingTable) Lookup(coverage float64) (Rating, error) {
+	if math.IsNaN(coverage) {
+		return Rating{}, fmt.Errorf("interest coverage is NaN")
+	}
+	for i, r := range t {
+		if i > 0 && r.MinCoverage >= t[i-1].MinCoverage {
+			return Rating{}, fmt.Errorf("rating table not in descending order at %s", r.Rating)
+		}
+		if coverage >= r.MinCoverage {
+			return r, nil
+		}
+	}
+	return Rating{}, fmt.Errorf("no rating for interest coverage %f", coverage)
+}
+
+// SyntheticRating returns the rating from the table for the interest coverage
+// ratio of the operating profit (EBIT) to the interest expense. A firm without
+// interest expense receives the highest rating.
+func SyntheticRating(ebit, interest float64, table RatingTable) (Rating, error) {
+	if interest <= 0.0 {
+		return table.Lookup(math.Inf(1))
+	}
+	return table.Lookup(fin.InterestCoverage(ebit, interest))
+}
+
+// CostOfDebt calculates the pre-tax cost of debt as the risk-free rate plus
+// the default spread for the rating.
+func (r Rating) CostOfDebt(riskFree float64) float64 {
+	return riskFree + r.Spread
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package credit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSyntheticRating(t *testing.T) {
+	testCases := []struct {
+		name       string
+		ebit       float64
+		interest   float64
+		table      RatingTable
+		rating     string
+		costOfDebt float64
+	}{
+		{"large_aaa", 1000, 100, LargeCap(), "AAA", 0.0459},
+		{"large_boundary", 425, 100, LargeCap(), "A", 0.0507},
+		{"large_bbb", 275, 100, LargeCap(), "BBB", 0.0547},
+		{"small_bbb", 425, 100, SmallCap(), "BBB", 0.0547},
+		{"small_b", 210, 100, SmallCap(), "B", 0.0738},
+		{"negative_ebit", -50, 100, SmallCap(), "D", 0.19},
+		{"no_interest", 50, 0, LargeCap(), "AAA", 0.0459},
+	}
+	for _, tc := range testCases {
+		got, err := SyntheticRating(tc.ebit, tc.interest, tc.table)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if got.Rating != tc.rating {
+			t.Errorf("%s: rating = %s, expected = %s", tc.name, got.Rating, tc.rating)
+		}
+		if cost := got.CostOfDebt(0.04); !almostEqual(cost, tc.costOfDebt) {
+			t.Errorf("%s: cost of debt = %f, expected = %f", tc.name, cost, tc.costOfDebt)
+		}
+	}
+}
+
+func TestRatingTableLookupErrors(t *testing.T) {
+	custom := RatingTable{{2.0, "IG", 0.01}, {1.0, "HY", 0.04}}
+	unordered := RatingTable{{1.0, "HY", 0.04}, {2.0, "IG", 0.01}}
+	testCases := []struct {
+		name     string
+		table    RatingTable
+		coverage float64
+	}{
+		{"below_table", custom, 0.5},
+		{"nan", LargeCap(), math.NaN()},
+		{"unordered", unordered, 0.5},
+		{"empty", RatingTable{}, 3.0},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.table.Lookup(tc.coverage); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+	if got, err := custom.Lookup(1.5); err != nil || got.Rating != "HY" {
+		t.Errorf("custom rating = %s, expected = HY", got.Rating)
+	}
+}
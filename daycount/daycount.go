@@ -0,0 +1,298 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package daycount
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Convention is the interface that wraps the YearFraction method.
+//
+// YearFraction returns the fraction of a year between the start and end dates
+// according to the day count convention. Only the calendar dates are used, so
+// the time of day and location are ignored.
+type Convention interface {
+	YearFraction(start, end time.Time) float64
+	String() string
+}
+
+// Thirty360US is the 30/360 US (also known as 30/360 SIA or Bond Basis with
+// end-of-month adjustments) day count convention.
+type Thirty360US struct{}
+
+// YearFraction implements the Convention interface.
+func (Thirty360US) YearFraction(start, end time.Time) float64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if isLastDayOfFeb(start) {
+		if isLastDayOfFeb(end) {
+			d2 = 30
+		}
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+	return thirty360(y1, int(m1), d1, y2, int(m2), d2)
+}
+
+func (Thirty360US) String() string {
+	return "30/360 US"
+}
+
+// Thirty360E is the 30E/360 (also known as Eurobond Basis) day count
+// convention.
+type Thirty360E struct{}
+
+// YearFraction implements the Convention interface.
+func (Thirty360E) YearFraction(start, end time.Time) float64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 {
+		d2 = 30
+	}
+	return thirty360(y1, int(m1), d1, y2, int(m2), d2)
+}
+
+func (Thirty360E) String() string {
+	return "30E/360"
+}
+
+// Thirty360ISDA is the 30E/360 ISDA day count convention. The last day of
+// February is not adjusted to day 30 when it is the Maturity date.
+type Thirty360ISDA struct {
+	Maturity time.Time
+}
+
+// YearFraction implements the Convention interface.
+func (c Thirty360ISDA) YearFraction(start, end time.Time) float64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if isLastDayOfMonth(start) {
+		d1 = 30
+	}
+	if isLastDayOfMonth(end) && !(m2 == time.February && sameDate(end, c.Maturity)) {
+		d2 = 30
+	}
+	return thirty360(y1, int(m1), d1, y2, int(m2), d2)
+}
+
+func (Thirty360ISDA) String() string {
+	return "30E/360 ISDA"
+}
+
+// Actual360 is the ACT/360 day count convention used by most money markets.
+type Actual360 struct{}
+
+// YearFraction implements the Convention interface.
+func (Actual360) YearFraction(start, end time.Time) float64 {
+	return float64(Days(start, end)) / 360
+}
+
+func (Actual360) String() string {
+	return "ACT/360"
+}
+
+// Actual365Fixed is the ACT/365 Fixed day count convention.
+type Actual365Fixed struct{}
+
+// YearFraction implements the Convention interface.
+func (Actual365Fixed) YearFraction(start, end time.Time) float64 {
+	return float64(Days(start, end)) / 365
+}
+
+func (Actual365Fixed) String() string {
+	return "ACT/365F"
+}
+
+// ActualActualISDA is the ACT/ACT ISDA day count convention, which divides the
+// days falling in a leap year by 366 and the remaining days by 365.
+type ActualActualISDA struct{}
+
+// YearFraction implements the Convention interface.
+func (ActualActualISDA) YearFraction(start, end time.Time) float64 {
+	if end.Before(start) {
+		return -ActualActualISDA{}.YearFraction(end, start)
+	}
+	yf := 0.0
+	for y := start.Year(); y <= end.Year(); y++ {
+		from := maxDate(start, date(y, time.January, 1))
+		to := minDate(end, date(y+1, time.January, 1))
+		yf += float64(Days(from, to)) / float64(daysInYear(y))
+	}
+	return yf
+}
+
+func (ActualActualISDA) String() string {
+	return "ACT/ACT ISDA"
+}
+
+// ActualActualICMA is the ACT/ACT ICMA day count convention used for bonds
+// paying Frequency coupons per year. The year fraction for each coupon period
+// is the actual days accrued divided by the Frequency times the actual days in
+// the coupon period. If the reference coupon period (RefStart and RefEnd) is
+// not given, regular coupon periods are rolled backward from the end date.
+// The Frequency must divide 12 (i.e., 1, 2, 3, 4, 6, or 12), and the year
+// fraction is NaN for any other Frequency.
+type ActualActualICMA struct {
+	Frequency int
+	RefStart  time.Time
+	RefEnd    time.Time
+}
+
+// NewActualActualICMA returns the ACT/ACT ICMA convention for the coupon
+// frequency, which must divide 12.
+func NewActualActualICMA(frequency int) (ActualActualICMA, error) {
+	if !validFrequency(frequency) {
+		return ActualActualICMA{}, fmt.Errorf("coupon frequency %d does not divide 12", frequency)
+	}
+	return ActualActualICMA{Frequency: frequency}, nil
+}
+
+// YearFraction implements the Convention interface.
+func (c ActualActualICMA) YearFraction(start, end time.Time) float64 {
+	if !validFrequency(c.Frequency) {
+		return math.NaN()
+	}
+	if end.Before(start) {
+		return -c.YearFraction(end, start)
+	}
+	start, end = date(start.Date()), date(end.Date())
+	freq := float64(c.Frequency)
+	if !c.RefStart.IsZero() && !c.RefEnd.IsZero() {
+		return float64(Days(start, end)) / (freq * float64(Days(c.RefStart, c.RefEnd)))
+	}
+	months := 12 / c.Frequency
+	yf := 0.0
+	periodEnd := end
+	for n := 1; periodEnd.After(start); n++ {
+		periodStart := AddMonths(end, -n*months)
+		if !periodStart.After(start) {
+			yf += float64(Days(start, periodEnd)) / (freq * float64(Days(periodStart, periodEnd)))
+			break
+		}
+		yf += 1 / freq
+		periodEnd = periodStart
+	}
+	return yf
+}
+
+func (c ActualActualICMA) String() string {
+	return "ACT/ACT ICMA"
+}
+
+// validFrequency determines if the coupon frequency gives whole-month coupon
+// periods.
+func validFrequency(frequency int) bool {
+	return frequency > 0 && frequency <= 12 && 12%frequency == 0
+}
+
+// BusinessDayer is the interface that wraps the IsBusinessDay method.
+type BusinessDayer interface {
+	IsBusinessDay(t time.Time) bool
+}
+
+// Business252 is the BUS/252 day count convention used in Brazil, which
+// divides the number of business days by 252. If the Calendar is nil, every
+// weekday is a business day.
+type Business252 struct {
+	Calendar BusinessDayer
+}
+
+// YearFraction implements the Convention interface.
+func (c Business252) YearFraction(start, end time.Time) float64 {
+	if end.Before(start) {
+		return -c.YearFraction(end, start)
+	}
+	days := 0
+	for d := date(start.Date()); d.Before(date(end.Date())); d = d.AddDate(0, 0, 1) {
+		if c.isBusinessDay(d) {
+			days++
+		}
+	}
+	return float64(days) / 252
+}
+
+func (c Business252) isBusinessDay(t time.Time) bool {
+	if c.Calendar != nil {
+		return c.Calendar.IsBusinessDay(t)
+	}
+	return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+}
+
+func (Business252) String() string {
+	return "BUS/252"
+}
+
+// Days returns the actual number of calendar days from the start date to the
+// end date.
+func Days(start, end time.Time) int {
+	return int(date(end.Date()).Sub(date(start.Date())).Hours() / 24)
+}
+
+// AddMonths adds the number of months to the date, clamping the day to the
+// last day of the resulting month (e.g., January 31 plus one month is the last
+// day of February).
+func AddMonths(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := date(y, m+time.Month(months), 1)
+	last := first.AddDate(0, 1, -1).Day()
+	if d > last {
+		d = last
+	}
+	return date(first.Year(), first.Month(), d)
+}
+
+func thirty360(y1, m1, d1, y2, m2, d2 int) float64 {
+	return float64(360*(y2-y1)+30*(m2-m1)+(d2-d1)) / 360
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func sameDate(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+func isLastDayOfMonth(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
+
+func isLastDayOfFeb(t time.Time) bool {
+	return t.Month() == time.February && isLastDayOfMonth(t)
+}
+
+func daysInYear(year int) int {
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 366
+	}
+	return 365
+}
+
+func maxDate(t1, t2 time.Time) time.Time {
+	if t1.After(t2) {
+		return t1
+	}
+	return t2
+}
+
+func minDate(t1, t2 time.Time) time.Time {
+	if t1.Before(t2) {
+		return t1
+	}
+	return t2
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package daycount
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const tolerance = 0.000001
+
+type holidays map[time.Time]bool
+
+func (h holidays) IsBusinessDay(t time.Time) bool {
+	return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday && !h[t]
+}
+
+func TestYearFraction(t *testing.T) {
+	testCases := []struct {
+		convention Convention
+		start      time.Time
+		end        time.Time
+		expected   float64
+	}{
+		{Thirty360US{}, date(2007, 1, 15), date(2007, 7, 15), 0.5},
+		{Thirty360US{}, date(2007, 2, 28), date(2007, 3, 31), 30.0 / 360},
+		{Thirty360US{}, date(2007, 1, 31), date(2007, 2, 28), 28.0 / 360},
+		{Thirty360E{}, date(2007, 2, 28), date(2007, 3, 31), 32.0 / 360},
+		{Thirty360E{}, date(2007, 1, 31), date(2007, 8, 31), 210.0 / 360},
+		{Thirty360ISDA{}, date(2007, 2, 28), date(2007, 3, 31), 30.0 / 360},
+		{Thirty360ISDA{}, date(2007, 8, 31), date(2008, 2, 29), 0.5},
+		{Thirty360ISDA{Maturity: date(2008, 2, 29)}, date(2007, 8, 31), date(2008, 2, 29), 179.0 / 360},
+		{Actual360{}, date(2007, 1, 1), date(2007, 7, 1), 181.0 / 360},
+		{Actual365Fixed{}, date(2008, 1, 1), date(2009, 1, 1), 366.0 / 365},
+		{ActualActualISDA{}, date(2003, 11, 1), date(2004, 5, 1), 61.0/365 + 121.0/366},
+		{ActualActualISDA{}, date(2004, 5, 1), date(2003, 11, 1), -(61.0/365 + 121.0/366)},
+		{ActualActualICMA{Frequency: 2}, date(2003, 11, 1), date(2004, 5, 1), 0.5},
+		{ActualActualICMA{Frequency: 2}, date(2003, 11, 1), date(2005, 5, 1), 1.5},
+		{ActualActualICMA{Frequency: 1}, date(1999, 2, 1), date(1999, 7, 1), 150.0 / 365},
+		{ActualActualICMA{Frequency: 1, RefStart: date(1998, 7, 1), RefEnd: date(1999, 7, 1)}, date(1999, 2, 1), date(1999, 7, 1), 150.0 / 365},
+		{Business252{}, date(2024, 1, 1), date(2024, 1, 8), 5.0 / 252},
+		{Business252{holidays{date(2024, 1, 1): true}}, date(2024, 1, 1), date(2024, 1, 8), 4.0 / 252},
+	}
+	for _, tc := range testCases {
+		got := tc.convention.YearFraction(tc.start, tc.end)
+		if math.Abs(got-tc.expected) > tolerance {
+			t.Errorf("%s from %s to %s = %f, expected = %f",
+				tc.convention, tc.start.Format("2006-01-02"), tc.end.Format("2006-01-02"), got, tc.expected)
+		}
+	}
+}
+
+func TestActualActualICMAFrequency(t *testing.T) {
+	for _, frequency := range []int{1, 2, 3, 4, 6, 12} {
+		c, err := NewActualActualICMA(frequency)
+		if err != nil {
+			t.Errorf("frequency %d: expected no error, got: %s", frequency, err)
+		}
+		if got := c.YearFraction(date(2024, 1, 1), date(2025, 1, 1)); math.Abs(got-1.0) > tolerance {
+			t.Errorf("frequency %d: year fraction = %f, expected = %f", frequency, got, 1.0)
+		}
+	}
+	for _, frequency := range []int{0, -2, 5, 24} {
+		if _, err := NewActualActualICMA(frequency); err == nil {
+			t.Errorf("frequency %d: expected an error", frequency)
+		}
+		c := ActualActualICMA{Frequency: frequency}
+		if got := c.YearFraction(date(2024, 1, 1), date(2025, 1, 1)); !math.IsNaN(got) {
+			t.Errorf("frequency %d: year fraction = %f, expected NaN", frequency, got)
+		}
+	}
+}
+
+func TestDays(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	testCases := []struct {
+		start    time.Time
+		end      time.Time
+		expected int
+	}{
+		{date(2024, 1, 1), date(2024, 3, 1), 60},
+		{date(2024, 3, 1), date(2024, 1, 1), -60},
+		// Crossing a daylight saving time change with a time of day.
+		{time.Date(2024, 3, 9, 23, 0, 0, 0, ny), time.Date(2024, 3, 11, 1, 0, 0, 0, ny), 2},
+	}
+	for _, tc := range testCases {
+		if got := Days(tc.start, tc.end); got != tc.expected {
+			t.Errorf("days from %s to %s = %d, expected = %d", tc.start, tc.end, got, tc.expected)
+		}
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	testCases := []struct {
+		given    time.Time
+		months   int
+		expected time.Time
+	}{
+		{date(2024, 1, 31), 1, date(2024, 2, 29)},
+		{date(2023, 1, 31), 1, date(2023, 2, 28)},
+		{date(2024, 3, 31), -6, date(2023, 9, 30)},
+		{date(2024, 11, 15), 3, date(2025, 2, 15)},
+	}
+	for _, tc := range testCases {
+		if got := AddMonths(tc.given, tc.months); !got.Equal(tc.expected) {
+			t.Errorf("%s plus %d months = %s, expected = %s", tc.given, tc.months, got, tc.expected)
+		}
+	}
+}
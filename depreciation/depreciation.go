@@ -0,0 +1,123 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package depreciation
+
+import (
+	"fmt"
+	"math"
+)
+
+// Method is the interface that wraps the Schedule method.
+//
+// Schedule returns the depreciation expense for each period of the asset's
+// life given the depreciable cost (i.e., the cost basis) of the asset. The
+// first element is the depreciation in period 1, since no depreciation is
+// taken when the asset is placed into service in period 0.
+type Method interface {
+	Schedule(cost float64) []float64
+}
+
+// StraightLine depreciates the cost less the salvage value evenly over the
+// life of the asset. The schedule is empty if the Life is less than one.
+type StraightLine struct {
+	Life    int
+	Salvage float64
+}
+
+// Schedule implements the Method interface.
+func (sl StraightLine) Schedule(cost float64) []float64 {
+	if sl.Life < 1 {
+		return []float64{}
+	}
+	schedule := make([]float64, sl.Life)
+	for i := range schedule {
+		schedule[i] = (cost - sl.Salvage) / float64(sl.Life)
+	}
+	return schedule
+}
+
+// DecliningBalance depreciates a constant fraction (Factor / Life) of the
+// remaining book value each period, switching to straight-line once
+// straight-line depreciation of the remaining book value is larger. A Factor
+// of 2 is the double-declining balance method. The schedule is empty if the
+// Life is less than one.
+type DecliningBalance struct {
+	Life    int
+	Factor  float64
+	Salvage float64
+}
+
+// Schedule implements the Method interface.
+func (db DecliningBalance) Schedule(cost float64) []float64 {
+	if db.Life < 1 {
+		return []float64{}
+	}
+	schedule := make([]float64, db.Life)
+	rate := db.Factor / float64(db.Life)
+	bookValue := cost
+	for i := range schedule {
+		remaining := float64(db.Life - i)
+		d := math.Max(bookValue*rate, (bookValue-db.Salvage)/remaining)
+		d = math.Min(d, bookValue-db.Salvage)
+		schedule[i] = d
+		bookValue -= d
+	}
+	return schedule
+}
+
+// macrsTables contains the IRS Modified Accelerated Cost Recovery System
+// (MACRS) General Depreciation System percentages using the half-year
+// convention. [Source: IRS Publication 946, Table A-1]
+var macrsTables = map[int][]float64{
+	3:  {0.3333, 0.4445, 0.1481, 0.0741},
+	5:  {0.2000, 0.3200, 0.1920, 0.1152, 0.1152, 0.0576},
+	7:  {0.1429, 0.2449, 0.1749, 0.1249, 0.0893, 0.0892, 0.0893, 0.0446},
+	10: {0.1000, 0.1800, 0.1440, 0.1152, 0.0922, 0.0737, 0.0655, 0.0655, 0.0656, 0.0655, 0.0328},
+	15: {0.0500, 0.0950, 0.0855, 0.0770, 0.0693, 0.0623, 0.0590, 0.0590, 0.0591, 0.0590, 0.0591, 0.0590, 0.0591, 0.0590, 0.0591, 0.0295},
+	20: {0.03750, 0.07219, 0.06677, 0.06177, 0.05713, 0.05285, 0.04888, 0.04522, 0.04462, 0.04461, 0.04462, 0.04461, 0.04462, 0.04461, 0.04462, 0.04461, 0.04462, 0.04461, 0.04462, 0.04461, 0.02231},
+}
+
+// MACRS depreciates the asset using the IRS MACRS percentages for the given
+// property class (3, 5, 7, 10, 15, or 20 years). Because of the half-year
+// convention, the schedule has one more period than the property class.
+// Use NewMACRS to create a MACRS method; the zero value has an empty schedule.
+type MACRS struct {
+	class int
+}
+
+// NewMACRS returns a new MACRS depreciation method for the given property
+// class.
+func NewMACRS(class int) (MACRS, error) {
+	if _, ok := macrsTables[class]; !ok {
+		return MACRS{}, fmt.Errorf("no MACRS table for %d-year property", class)
+	}
+	return MACRS{class: class}, nil
+}
+
+// Class returns the property class in years.
+func (m MACRS) Class() int {
+	return m.class
+}
+
+// Schedule implements the Method interface.
+func (m MACRS) Schedule(cost float64) []float64 {
+	table := macrsTables[m.class]
+	schedule := make([]float64, len(table))
+	for i, pct := range table {
+		schedule[i] = cost * pct
+	}
+	return schedule
+}
+
+// BookValue calculates the book value remaining after the given number of
+// periods of depreciation.
+func BookValue(cost float64, schedule []float64, periods int) float64 {
+	bookValue := cost
+	for i := 0; i < periods && i < len(schedule); i++ {
+		bookValue -= schedule[i]
+	}
+	return bookValue
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package depreciation
+
+import (
+	"math"
+	"testing"
+)
+
+const tolerance = 0.000001
+
+func TestSchedule(t *testing.T) {
+	macrs3, err := NewMACRS(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name     string
+		method   Method
+		cost     float64
+		expected []float64
+	}{
+		{"straight_line", StraightLine{5, 500}, 5000, []float64{900, 900, 900, 900, 900}},
+		{"double_declining", DecliningBalance{5, 2, 0}, 10000, []float64{4000, 2400, 1440, 1080, 1080}},
+		{"declining_salvage", DecliningBalance{4, 2, 1000}, 10000, []float64{5000, 2500, 1250, 250}},
+		{"straight_line_no_life", StraightLine{0, 0}, 5000, []float64{}},
+		{"straight_line_negative_life", StraightLine{-1, 0}, 5000, []float64{}},
+		{"declining_negative_life", DecliningBalance{-3, 2, 0}, 10000, []float64{}},
+		{"macrs_3", macrs3, 10000, []float64{3333, 4445, 1481, 741}},
+	}
+	for _, tc := range testCases {
+		got := tc.method.Schedule(tc.cost)
+		if len(got) != len(tc.expected) {
+			t.Errorf("%s: schedule length = %d, expected = %d", tc.name, len(got), len(tc.expected))
+			continue
+		}
+		for i := range got {
+			if !almostEqual(got[i], tc.expected[i]) {
+				t.Errorf("%s: period %d depreciation = %f, expected = %f", tc.name, i+1, got[i], tc.expected[i])
+			}
+		}
+	}
+}
+
+func TestNewMACRS(t *testing.T) {
+	for _, class := range []int{3, 5, 7, 10, 15, 20} {
+		m, err := NewMACRS(class)
+		if err != nil {
+			t.Errorf("expected no error for class %d, got: %s", class, err)
+		}
+		if m.Class() != class {
+			t.Errorf("class = %d, expected = %d", m.Class(), class)
+		}
+		// Each MACRS table should fully depreciate the asset.
+		total := 0.0
+		for _, d := range m.Schedule(1.0) {
+			total += d
+		}
+		if math.Abs(total-1.0) > 0.0001 {
+			t.Errorf("MACRS %d-year total = %f, expected = 1.0", class, total)
+		}
+	}
+	if _, err := NewMACRS(4); err == nil {
+		t.Errorf("expected an error for a 4-year MACRS class")
+	}
+}
+
+func TestBookValue(t *testing.T) {
+	schedule := StraightLine{5, 0}.Schedule(1000)
+	testCases := []struct {
+		periods  int
+		expected float64
+	}{
+		{0, 1000},
+		{2, 600},
+		{5, 0},
+		{7, 0},
+	}
+	for _, tc := range testCases {
+		got := BookValue(1000, schedule, tc.periods)
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("book value after %d periods = %f, expected = %f", tc.periods, got, tc.expected)
+		}
+	}
+}
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) < tolerance
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package main
+
+import (
+	"log"
+
+	"github.com/goinvest/fin/cf"
+	"github.com/goinvest/fin/goalseek"
+)
+
+func main() {
+
+	// Find the unit price that gives an IRR of 15% when selling 100 units per
+	// period at a variable cost of $6 per unit.
+	irr := func(price float64) float64 {
+		ocf := (price - 6) * 100
+		rate, _ := cf.IRR([]float64{-1000, ocf, ocf, ocf})
+		return rate
+	}
+	result, err := goalseek.Seek(irr, 0.15, goalseek.Options{
+		Method:       goalseek.Secant,
+		InitialGuess: 10,
+		SecondGuess:  11,
+	})
+	if err != nil {
+		log.Fatalf("error seeking price: %s", err)
+	}
+	log.Printf("Price = %f after %d iterations", result.X, result.Iterations)
+}
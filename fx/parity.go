@@ -0,0 +1,124 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package fx
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Forward calculates the forward exchange rate for the given number of years
+// using covered interest parity, where the home and foreign rates are the
+// annually compounded interest rates in each currency. Exchange rates are
+// direct quotes stated as units of the home currency per unit of the foreign
+// currency (e.g., 1.10 USD per EUR for a US company).
+//
+// F = S * ((1 + r_home) / (1 + r_foreign))^years
+func Forward(spot, homeRate, foreignRate, years float64) float64 {
+	return spot * math.Pow((1+homeRate)/(1+foreignRate), years)
+}
+
+// ForwardPoints calculates the forward points, which are the difference
+// between the forward and spot rates stated in pips. The pip is the smallest
+// quoted increment of the exchange rate, such as 0.0001 for most currency
+// pairs and 0.01 for pairs quoted in yen.
+func ForwardPoints(spot, forward, pip float64) float64 {
+	return (forward - spot) / pip
+}
+
+// ForwardPremium calculates the annualized forward premium (or discount, if
+// negative) of the forward rate over the spot rate.
+func ForwardPremium(spot, forward, years float64) float64 {
+	return math.Pow(forward/spot, 1/years) - 1
+}
+
+// Basis is the basis for the exchange rates used to convert foreign cash
+// flows into the home currency.
+type Basis int
+
+// Bases for converting foreign cash flows.
+//
+// CoveredParity uses the forward rates implied by covered interest parity,
+// which are the rates at which the cash flows can be hedged. UncoveredParity
+// uses the same rates as the expected future spot rates, assuming the
+// currency with the higher interest rate depreciates to offset its interest
+// rate advantage. PurchasingPowerParity uses the expected future spot rates
+// implied by relative purchasing power parity, where the currency with the
+// higher inflation rate depreciates.
+const (
+	CoveredParity         Basis = 1
+	UncoveredParity       Basis = 2
+	PurchasingPowerParity Basis = 3
+)
+
+// Conversion models converting foreign cash flows for periods 0 through n,
+// measured in years, into the home currency. The interest rates are used for
+// the covered and uncovered parity bases, and the inflation rates are used
+// for the purchasing power parity basis.
+type Conversion struct {
+	Spot             float64
+	Basis            Basis
+	HomeRate         float64
+	ForeignRate      float64
+	HomeInflation    float64
+	ForeignInflation float64
+}
+
+// Rates returns the exchange rates for periods 0 through n-1, where the rate
+// for period 0 is the spot rate.
+func (c Conversion) Rates(n int) ([]float64, error) {
+	var home, foreign float64
+	switch c.Basis {
+	case CoveredParity, UncoveredParity:
+		home, foreign = c.HomeRate, c.ForeignRate
+	case PurchasingPowerParity:
+		home, foreign = c.HomeInflation, c.ForeignInflation
+	default:
+		return nil, fmt.Errorf("unknown conversion basis %d", c.Basis)
+	}
+	if c.Spot <= 0.0 {
+		return nil, fmt.Errorf("spot rate %f must be positive", c.Spot)
+	}
+	rates := make([]float64, n)
+	for t := range rates {
+		rates[t] = Forward(c.Spot, home, foreign, float64(t))
+	}
+	return rates, nil
+}
+
+// Convert converts the foreign cash flows into home currency cash flows.
+func (c Conversion) Convert(cashflows []float64) ([]float64, error) {
+	rates, err := c.Rates(len(cashflows))
+	if err != nil {
+		return nil, err
+	}
+	return ToHome(cashflows, rates)
+}
+
+// NPV calculates the home currency NPV of the foreign cash flows using the
+// home currency discount rate (k).
+func (c Conversion) NPV(cashflows []float64, k float64) (float64, error) {
+	home, err := c.Convert(cashflows)
+	if err != nil {
+		return math.NaN(), err
+	}
+	return cf.NPV(home, k), nil
+}
+
+// ToHome converts the foreign cash flows into home currency cash flows using
+// the exchange rate for each period, such as quoted forward rates.
+func ToHome(cashflows, rates []float64) ([]float64, error) {
+	if len(rates) != len(cashflows) {
+		return nil, fmt.Errorf("have %d exchange rates for %d cashflows", len(rates), len(cashflows))
+	}
+	home := make([]float64, len(cashflows))
+	for t, cashflow := range cashflows {
+		home[t] = cashflow * rates[t]
+	}
+	return home, nil
+}
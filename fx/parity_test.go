@@ -0,0 +1,117 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package fx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goinvest/fin/cf"
+)
+
+const tolerance = 0.000001
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) <= tolerance
+}
+
+func TestForward(t *testing.T) {
+	testCases := []struct {
+		spot        float64
+		homeRate    float64
+		foreignRate float64
+		years       float64
+		pip         float64
+		forward     float64
+		points      float64
+	}{
+		{1.10, 0.05, 0.03, 1, 0.0001, 1.121359, 213.592233},
+		{1.10, 0.05, 0.03, 0.5, 0.0001, 1.110628, 106.282662},
+		{110, 0.01, 0.04, 1, 0.01, 106.826923, -317.307692},
+		{1.25, 0.02, 0.02, 2, 0.0001, 1.25, 0},
+	}
+	for _, tc := range testCases {
+		got := Forward(tc.spot, tc.homeRate, tc.foreignRate, tc.years)
+		if !almostEqual(got, tc.forward) {
+			t.Errorf("forward calculated = %f, expected = %f", got, tc.forward)
+		}
+		if points := ForwardPoints(tc.spot, got, tc.pip); !almostEqual(points, tc.points) {
+			t.Errorf("forward points calculated = %f, expected = %f", points, tc.points)
+		}
+		premium := ForwardPremium(tc.spot, got, tc.years)
+		if expected := (1+tc.homeRate)/(1+tc.foreignRate) - 1; !almostEqual(premium, expected) {
+			t.Errorf("forward premium calculated = %f, expected = %f", premium, expected)
+		}
+	}
+}
+
+func TestConversion(t *testing.T) {
+	foreign := []float64{-1000, 400, 400, 400}
+	testCases := []struct {
+		name       string
+		conversion Conversion
+		home       []float64
+	}{
+		{
+			"covered",
+			Conversion{Spot: 1.10, Basis: CoveredParity, HomeRate: 0.05, ForeignRate: 0.03},
+			[]float64{-1100, 448.543689, 457.253275, 466.131980},
+		},
+		{
+			"uncovered",
+			Conversion{Spot: 1.10, Basis: UncoveredParity, HomeRate: 0.05, ForeignRate: 0.03},
+			[]float64{-1100, 448.543689, 457.253275, 466.131980},
+		},
+		{
+			"ppp",
+			Conversion{Spot: 1.10, Basis: PurchasingPowerParity, HomeInflation: 0.02, ForeignInflation: 0.04},
+			[]float64{-1100, 431.538462, 423.239645, 415.100421},
+		},
+	}
+	for _, tc := range testCases {
+		got, err := tc.conversion.Convert(foreign)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		for i := range tc.home {
+			if !almostEqual(got[i], tc.home[i]) {
+				t.Errorf("%s: home cashflow %d = %f, expected = %f", tc.name, i, got[i], tc.home[i])
+			}
+		}
+	}
+
+	// Discounting the hedged cash flows at the home rate gives the same NPV
+	// as converting the foreign NPV at the spot rate.
+	c := Conversion{Spot: 1.10, Basis: CoveredParity, HomeRate: 0.05, ForeignRate: 0.03}
+	npv, err := c.NPV(foreign, 0.05)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := 1.10 * cf.NPV(foreign, 0.03); !almostEqual(npv, expected) {
+		t.Errorf("home NPV calculated = %f, expected = %f", npv, expected)
+	}
+}
+
+func TestConversionErrors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conversion Conversion
+	}{
+		{"no_basis", Conversion{Spot: 1.10}},
+		{"zero_spot", Conversion{Basis: CoveredParity}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.conversion.Convert([]float64{-100, 110}); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+		if npv, err := tc.conversion.NPV([]float64{-100, 110}, 0.10); err == nil || !math.IsNaN(npv) {
+			t.Errorf("%s: NPV = %f, expected NaN and an error", tc.name, npv)
+		}
+	}
+	if _, err := ToHome([]float64{-100, 110}, []float64{1.10}); err == nil {
+		t.Errorf("expected an error for mismatched exchange rates")
+	}
+}
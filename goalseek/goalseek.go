@@ -0,0 +1,243 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package goalseek
+
+import (
+	"fmt"
+	"math"
+)
+
+// ToleranceType determines whether the tolerance is compared against the
+// absolute or relative change in x between iterations.
+type ToleranceType int
+
+// Tolerance types.
+const (
+	Relative ToleranceType = 1
+	Absolute ToleranceType = 2
+)
+
+// Method is the root-finding method used to seek the goal.
+type Method int
+
+// Root-finding methods.
+const (
+	Newton    Method = 1
+	Secant    Method = 2
+	Bisection Method = 3
+)
+
+func (m Method) String() string {
+	switch m {
+	case Newton:
+		return "Newton"
+	case Secant:
+		return "secant"
+	case Bisection:
+		return "bisection"
+	}
+	return fmt.Sprintf("Method(%d)", int(m))
+}
+
+// Options models the options for seeking a goal. Newton's method uses the
+// Derivative if provided; otherwise, the derivative is estimated numerically.
+// The secant method starts from the InitialGuess and the SecondGuess. The
+// bisection method requires the root to be bracketed by Lower and Upper.
+type Options struct {
+	Method        Method
+	InitialGuess  float64
+	SecondGuess   float64
+	Lower         float64
+	Upper         float64
+	Tolerance     float64
+	ToleranceType ToleranceType
+	MaxIterations int
+	Derivative    func(float64) float64
+}
+
+// Result contains the value of x found for the goal along with convergence
+// diagnostics. Residual is f(x) minus the target.
+type Result struct {
+	X          float64
+	Residual   float64
+	Iterations int
+	Method     Method
+}
+
+// Seek finds the value of x for which f(x) equals the target, such as the
+// price that gives an IRR of 15% or the loan term that gives a DSCR of 1.25.
+//
+// If Seek is called without the optional struct, the defaults will be
+// method = Newton, initialGuess = 0.0, secondGuess = initialGuess + 0.1,
+// tolerance = 1e-8, toleranceType = Absolute, and maxIterations = 100.
+func Seek(f func(float64) float64, target float64, opts ...Options) (Result, error) {
+	g := func(x float64) float64 {
+		return f(x) - target
+	}
+	var dg func(float64) float64
+	if len(opts) > 0 && opts[0].Derivative != nil {
+		dg = opts[0].Derivative
+	}
+	return Root(g, dg, opts...)
+}
+
+// Root finds the value of x for which f(x) equals zero. The derivative df is
+// only used by Newton's method and may be nil, in which case the derivative is
+// estimated numerically using a central difference.
+func Root(f, df func(float64) float64, opts ...Options) (Result, error) {
+	o := options(opts)
+	if df == nil {
+		df = numericalDerivative(f)
+	}
+
+	switch o.Method {
+	case Newton:
+		fdf := func(x float64) (float64, float64) {
+			return f(x), df(x)
+		}
+		return newton(fdf, o)
+	case Secant:
+		return secant(f, o)
+	case Bisection:
+		return bisection(f, o)
+	}
+	return Result{X: math.NaN(), Method: o.Method}, fmt.Errorf("unknown method %s", o.Method)
+}
+
+// RootNewton finds the value of x for which f(x) equals zero using Newton's
+// method, where fdf returns both f(x) and its derivative at x. Evaluating
+// both together avoids a second pass when f and its derivative share work,
+// such as the NPV and its derivative with respect to the discount rate. The
+// Method and Derivative options are ignored.
+func RootNewton(fdf func(float64) (float64, float64), opts ...Options) (Result, error) {
+	o := options(opts)
+	o.Method = Newton
+	return newton(fdf, o)
+}
+
+// options returns the options with the defaults for any options not provided.
+func options(opts []Options) Options {
+	o := Options{
+		Method:        Newton,
+		Tolerance:     1e-8,
+		ToleranceType: Absolute,
+		MaxIterations: 100,
+	}
+
+	// Override default options if provided.
+	if len(opts) > 0 {
+		if opts[0].Method != 0 {
+			o.Method = opts[0].Method
+		}
+		o.InitialGuess = opts[0].InitialGuess
+		o.SecondGuess = opts[0].SecondGuess
+		o.Lower = opts[0].Lower
+		o.Upper = opts[0].Upper
+		if opts[0].Tolerance != 0.0 {
+			o.Tolerance = opts[0].Tolerance
+		}
+		if opts[0].ToleranceType != 0 {
+			o.ToleranceType = opts[0].ToleranceType
+		}
+		if opts[0].MaxIterations != 0 {
+			o.MaxIterations = opts[0].MaxIterations
+		}
+	}
+	if o.SecondGuess == 0.0 {
+		o.SecondGuess = o.InitialGuess + 0.1
+	}
+	return o
+}
+
+func newton(fdf func(float64) (float64, float64), o Options) (Result, error) {
+	x := o.InitialGuess
+	for i := 1; i <= o.MaxIterations; i++ {
+		fx, dfx := fdf(x)
+		if math.Abs(dfx) < 1e-12 {
+			return Result{X: math.NaN(), Residual: fx, Iterations: i, Method: o.Method},
+				fmt.Errorf("derivative too close to zero, cannot converge")
+		}
+		newX := x - fx/dfx
+		if o.converged(x, newX) {
+			residual, _ := fdf(newX)
+			return Result{X: newX, Residual: residual, Iterations: i, Method: o.Method}, nil
+		}
+		x = newX
+	}
+	residual, _ := fdf(x)
+	return Result{X: math.NaN(), Residual: residual, Iterations: o.MaxIterations, Method: o.Method},
+		fmt.Errorf("failed to converge after %d iterations", o.MaxIterations)
+}
+
+func secant(f func(float64) float64, o Options) (Result, error) {
+	x0, x1 := o.InitialGuess, o.SecondGuess
+	f0 := f(x0)
+	for i := 1; i <= o.MaxIterations; i++ {
+		f1 := f(x1)
+		if f1 == f0 {
+			return Result{X: math.NaN(), Residual: f1, Iterations: i, Method: o.Method},
+				fmt.Errorf("secant slope is zero, cannot converge")
+		}
+		newX := x1 - f1*(x1-x0)/(f1-f0)
+		if o.converged(x1, newX) {
+			return Result{X: newX, Residual: f(newX), Iterations: i, Method: o.Method}, nil
+		}
+		x0, f0, x1 = x1, f1, newX
+	}
+	return Result{X: math.NaN(), Residual: f(x1), Iterations: o.MaxIterations, Method: o.Method},
+		fmt.Errorf("failed to converge after %d iterations", o.MaxIterations)
+}
+
+func bisection(f func(float64) float64, o Options) (Result, error) {
+	lo, hi := o.Lower, o.Upper
+	if lo >= hi {
+		return Result{X: math.NaN(), Method: o.Method},
+			fmt.Errorf("lower bound %f must be less than upper bound %f", lo, hi)
+	}
+	fLo, fHi := f(lo), f(hi)
+	if fLo == 0.0 {
+		return Result{X: lo, Method: o.Method}, nil
+	}
+	if fHi == 0.0 {
+		return Result{X: hi, Method: o.Method}, nil
+	}
+	if math.Signbit(fLo) == math.Signbit(fHi) {
+		return Result{X: math.NaN(), Method: o.Method},
+			fmt.Errorf("root not bracketed by [%f, %f]", lo, hi)
+	}
+	for i := 1; i <= o.MaxIterations; i++ {
+		mid := lo + (hi-lo)/2
+		fMid := f(mid)
+		if fMid == 0.0 || o.converged(lo, mid) {
+			return Result{X: mid, Residual: fMid, Iterations: i, Method: o.Method}, nil
+		}
+		if math.Signbit(fMid) == math.Signbit(fLo) {
+			lo, fLo = mid, fMid
+		} else {
+			hi = mid
+		}
+	}
+	mid := lo + (hi-lo)/2
+	return Result{X: math.NaN(), Residual: f(mid), Iterations: o.MaxIterations, Method: o.Method},
+		fmt.Errorf("failed to converge after %d iterations", o.MaxIterations)
+}
+
+// converged determines if the change from x to newX is within the tolerance.
+func (o Options) converged(x, newX float64) bool {
+	if o.ToleranceType == Relative {
+		return math.Abs(newX-x)/math.Abs(x) < o.Tolerance
+	}
+	return math.Abs(newX-x) < o.Tolerance
+}
+
+// numericalDerivative returns a function estimating the derivative of f using
+// a central difference.
+func numericalDerivative(f func(float64) float64) func(float64) float64 {
+	return func(x float64) float64 {
+		h := 1e-6 * math.Max(1.0, math.Abs(x))
+		return (f(x+h) - f(x-h)) / (2 * h)
+	}
+}
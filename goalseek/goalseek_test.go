@@ -0,0 +1,119 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package goalseek
+
+import (
+	"math"
+	"testing"
+)
+
+const tolerance = 0.000001
+
+func square(x float64) float64 {
+	return x * x
+}
+
+func TestSeek(t *testing.T) {
+	testCases := []struct {
+		name     string
+		target   float64
+		options  Options
+		expected float64
+	}{
+		{"newton_numerical", 2.0, Options{InitialGuess: 1.0}, math.Sqrt2},
+		{"newton_analytic", 2.0, Options{InitialGuess: 1.0, Derivative: func(x float64) float64 { return 2 * x }}, math.Sqrt2},
+		{"newton_relative", 9.0, Options{InitialGuess: 1.0, ToleranceType: Relative}, 3.0},
+		{"secant", 2.0, Options{Method: Secant, InitialGuess: 1.0, SecondGuess: 2.0}, math.Sqrt2},
+		{"secant_default_second", 4.0, Options{Method: Secant, InitialGuess: 1.0}, 2.0},
+		{"bisection", 2.0, Options{Method: Bisection, Lower: 0.0, Upper: 2.0}, math.Sqrt2},
+		{"bisection_negative", 2.0, Options{Method: Bisection, Lower: -2.0, Upper: -1.0}, -math.Sqrt2},
+		{"bisection_at_bound", 4.0, Options{Method: Bisection, Lower: 0.0, Upper: 2.0}, 2.0},
+	}
+	for _, tc := range testCases {
+		got, err := Seek(square, tc.target, tc.options)
+		if err != nil {
+			t.Errorf("%s: expected no error, got: %s", tc.name, err)
+			continue
+		}
+		if math.Abs(got.X-tc.expected) > 0.00001 {
+			t.Errorf("%s: x = %f, expected = %f", tc.name, got.X, tc.expected)
+		}
+		if math.Abs(got.Residual) > 0.0001 {
+			t.Errorf("%s: residual = %g, expected ~0", tc.name, got.Residual)
+		}
+		if got.Method != tc.options.Method && tc.options.Method != 0 {
+			t.Errorf("%s: method = %s, expected = %s", tc.name, got.Method, tc.options.Method)
+		}
+	}
+}
+
+func TestSeekWithoutOptions(t *testing.T) {
+	// Find the rate for which $1,000 grows to $1,500 over 5 periods.
+	fv := func(rate float64) float64 {
+		return 1000 * math.Pow(1+rate, 5)
+	}
+	got, err := Seek(fv, 1500)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if math.Abs(got.X-0.084472) > tolerance {
+		t.Errorf("rate = %f, expected = %f", got.X, 0.084472)
+	}
+	if got.Method != Newton || got.Iterations < 1 {
+		t.Errorf("diagnostics = %+v, expected Newton with iterations", got)
+	}
+}
+
+func TestRootErrors(t *testing.T) {
+	constant := func(x float64) float64 { return 1.0 }
+	testCases := []struct {
+		name    string
+		f       func(float64) float64
+		options Options
+	}{
+		{"zero_derivative", constant, Options{}},
+		{"zero_slope", constant, Options{Method: Secant}},
+		{"not_bracketed", square, Options{Method: Bisection, Lower: 1.0, Upper: 2.0}},
+		{"bad_bracket", square, Options{Method: Bisection, Lower: 2.0, Upper: 1.0}},
+		{"max_iterations", func(x float64) float64 { return x*x - 2 }, Options{InitialGuess: 100, MaxIterations: 2}},
+		{"unknown_method", square, Options{Method: Method(9)}},
+	}
+	for _, tc := range testCases {
+		got, err := Root(tc.f, nil, tc.options)
+		if err == nil {
+			t.Errorf("%s: expected an error, got x = %f", tc.name, got.X)
+		}
+		if !math.IsNaN(got.X) {
+			t.Errorf("%s: x = %f, expected NaN", tc.name, got.X)
+		}
+	}
+}
+
+func TestRootNewton(t *testing.T) {
+	calls := 0
+	fdf := func(x float64) (float64, float64) {
+		calls++
+		return x*x - 2, 2 * x
+	}
+	got, err := RootNewton(fdf, Options{Method: Bisection, InitialGuess: 1.0})
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if math.Abs(got.X-math.Sqrt2) > tolerance {
+		t.Errorf("x = %f, expected = %f", got.X, math.Sqrt2)
+	}
+	if got.Method != Newton {
+		t.Errorf("method = %s, expected = %s", got.Method, Newton)
+	}
+	// Each iteration evaluates fdf once, plus once for the final residual.
+	if calls != got.Iterations+1 {
+		t.Errorf("evaluations = %d, expected = %d", calls, got.Iterations+1)
+	}
+
+	if _, err := RootNewton(func(x float64) (float64, float64) { return 1.0, 0.0 }); err == nil {
+		t.Errorf("expected an error for a zero derivative")
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package lease
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Classification is the lessee classification of a lease, which determines
+// how the right-of-use (ROU) asset is expensed.
+type Classification int
+
+// Lease classifications. Under IFRS 16 all leases are accounted for as
+// finance leases, while ASC 842 distinguishes finance and operating leases.
+const (
+	Finance   Classification = 1
+	Operating Classification = 2
+)
+
+// Lease models the information needed to account for a lease by the lessee.
+// Payments are given per period and the Rate is the incremental borrowing
+// rate (or the rate implicit in the lease) per period.
+type Lease struct {
+	Payments           []float64
+	Rate               float64
+	Advance            bool
+	InitialDirectCosts float64
+	Incentives         float64
+	Class              Classification
+	Modifications      []Modification
+}
+
+// Modification models a change to the lease that requires the lease liability
+// to be remeasured at the start of the given period (1-based). The Payments
+// are the revised payments from that period through the revised end of the
+// lease, which are discounted at the revised Rate.
+type Modification struct {
+	Period   int
+	Payments []float64
+	Rate     float64
+}
+
+// Entry contains the lessee accounting for a single period. For a finance
+// lease the ROU asset is depreciated straight-line and the lease cost is the
+// interest plus depreciation. For an operating lease under ASC 842 a single
+// straight-line lease cost is recognized and the ROU asset amortization is
+// the lease cost less the interest on the liability.
+type Entry struct {
+	Period        int
+	Payment       float64
+	Interest      float64
+	Amortization  float64 // Reduction of the lease liability
+	Liability     float64 // Lease liability at the end of the period
+	Depreciation  float64 // Reduction of the ROU asset
+	LeaseCost     float64
+	ROUAsset      float64 // ROU asset at the end of the period
+	Remeasurement float64 // Change in liability from a modification
+}
+
+// InitialLiability calculates the lease liability at commencement, which is
+// the present value of the lease payments discounted at the rate per period.
+// When the payments are made in advance, the first payment is not discounted.
+func InitialLiability(payments []float64, rate float64, advance bool) float64 {
+	liability := 0.0
+	for i, payment := range payments {
+		n := float64(i + 1)
+		if advance {
+			n = float64(i)
+		}
+		liability += payment / math.Pow(1+rate, n)
+	}
+	return liability
+}
+
+// InitialROUAsset calculates the right-of-use asset at commencement, which is
+// the initial lease liability plus initial direct costs less any lease
+// incentives received.
+func (l Lease) InitialROUAsset() float64 {
+	return InitialLiability(l.Payments, l.Rate, l.Advance) + l.InitialDirectCosts - l.Incentives
+}
+
+// Schedule generates the per-period lessee accounting entries over the life of
+// the lease, including the remeasurement of the liability and the adjustment
+// of the ROU asset for any modifications.
+func (l Lease) Schedule() ([]Entry, error) {
+	if l.Class != Finance && l.Class != Operating {
+		return nil, fmt.Errorf("unknown lease classification %d", l.Class)
+	}
+	if len(l.Payments) == 0 {
+		return nil, fmt.Errorf("need at least one lease payment")
+	}
+
+	payments := append([]float64{}, l.Payments...)
+	rate := l.Rate
+	liability := InitialLiability(payments, rate, l.Advance)
+	rou := l.InitialROUAsset()
+	cost := l.straightLineCost(payments, 0, rou, liability)
+
+	// Check each modification against the lease term as revised by the
+	// earlier modifications.
+	sorted := append([]Modification{}, l.Modifications...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Period < sorted[j].Period
+	})
+	mods := make(map[int]Modification, len(sorted))
+	term := len(payments)
+	for _, mod := range sorted {
+		if _, ok := mods[mod.Period]; ok {
+			return nil, fmt.Errorf("more than one modification in period %d", mod.Period)
+		}
+		if mod.Period < 1 || mod.Period > term {
+			return nil, fmt.Errorf("modification period %d outside of lease term of %d periods", mod.Period, term)
+		}
+		if len(mod.Payments) == 0 {
+			return nil, fmt.Errorf("modification in period %d has no payments", mod.Period)
+		}
+		mods[mod.Period] = mod
+		term = mod.Period - 1 + len(mod.Payments)
+	}
+
+	var entries []Entry
+	for i := 0; i < len(payments); i++ {
+		period := i + 1
+		entry := Entry{Period: period}
+
+		// Remeasure the liability using the revised payments and rate with the
+		// change adjusting the ROU asset.
+		if mod, ok := mods[period]; ok {
+			payments = append(payments[:i], mod.Payments...)
+			rate = mod.Rate
+			remeasured := InitialLiability(mod.Payments, rate, l.Advance)
+			entry.Remeasurement = remeasured - liability
+			rou += entry.Remeasurement
+			liability = remeasured
+			cost = l.straightLineCost(payments, i, rou, liability)
+		}
+
+		payment := payments[i]
+		if l.Advance {
+			entry.Interest = (liability - payment) * rate
+		} else {
+			entry.Interest = liability * rate
+		}
+		entry.Payment = payment
+		entry.Amortization = payment - entry.Interest
+		liability -= entry.Amortization
+
+		remaining := float64(len(payments) - i)
+		switch l.Class {
+		case Finance:
+			entry.Depreciation = rou / remaining
+			entry.LeaseCost = entry.Interest + entry.Depreciation
+		case Operating:
+			entry.LeaseCost = cost
+			entry.Depreciation = cost - entry.Interest
+		}
+		rou -= entry.Depreciation
+
+		entry.Liability = liability
+		entry.ROUAsset = rou
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// straightLineCost calculates the single operating lease cost for each of the
+// remaining periods starting with period index i, which is the remaining
+// payments plus the difference between the ROU asset and the lease liability
+// spread evenly over the remaining lease term.
+func (l Lease) straightLineCost(payments []float64, i int, rou, liability float64) float64 {
+	remaining := payments[i:]
+	total := rou - liability
+	for _, payment := range remaining {
+		total += payment
+	}
+	return total / float64(len(remaining))
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package lease
+
+import (
+	"testing"
+)
+
+func TestInitialLiability(t *testing.T) {
+	testCases := []struct {
+		payments []float64
+		rate     float64
+		advance  bool
+		expected float64
+	}{
+		{[]float64{1000, 1000, 1000}, 0.05, false, 2723.248029},
+		{[]float64{1000, 1000, 1000}, 0.05, true, 2859.410431},
+		{[]float64{500, 500}, 0.0, false, 1000.0},
+	}
+	for _, tc := range testCases {
+		got := InitialLiability(tc.payments, tc.rate, tc.advance)
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("initial liability = %f, expected = %f", got, tc.expected)
+		}
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	testCases := []struct {
+		name      string
+		lease     Lease
+		interest  float64 // Interest in the first period
+		leaseCost float64 // Lease cost in the first period
+	}{
+		{
+			"finance_arrears",
+			Lease{Payments: []float64{1000, 1000, 1000}, Rate: 0.05, Class: Finance},
+			136.162401, 136.162401 + 2723.248029/3,
+		},
+		{
+			"finance_advance",
+			Lease{Payments: []float64{1000, 1000, 1000}, Rate: 0.05, Advance: true, Class: Finance},
+			92.970522, 92.970522 + 2859.410431/3,
+		},
+		{
+			"operating_costs_incentives",
+			Lease{Payments: []float64{1000, 1000, 1000}, Rate: 0.05, InitialDirectCosts: 90, Incentives: 30, Class: Operating},
+			136.162401, 1020.0,
+		},
+		{
+			"operating_modified",
+			Lease{
+				Payments: []float64{1000, 1000, 1000},
+				Rate:     0.05,
+				Class:    Operating,
+				Modifications: []Modification{
+					{Period: 2, Payments: []float64{1200, 1200, 1200}, Rate: 0.06},
+				},
+			},
+			136.162401, 1000.0,
+		},
+		{
+			"finance_modified",
+			Lease{
+				Payments: []float64{1000, 1000, 1000, 1000},
+				Rate:     0.05,
+				Advance:  true,
+				Class:    Finance,
+				Modifications: []Modification{
+					{Period: 3, Payments: []float64{800}, Rate: 0.04},
+				},
+			},
+			(InitialLiability([]float64{1000, 1000, 1000, 1000}, 0.05, true) - 1000) * 0.05,
+			0.0,
+		},
+	}
+	for _, tc := range testCases {
+		entries, err := tc.lease.Schedule()
+		if err != nil {
+			t.Errorf("%s: expected no error, got: %s", tc.name, err)
+			continue
+		}
+		if !almostEqual(entries[0].Interest, tc.interest) {
+			t.Errorf("%s: first interest = %f, expected = %f", tc.name, entries[0].Interest, tc.interest)
+		}
+		if tc.leaseCost != 0.0 && !almostEqual(entries[0].LeaseCost, tc.leaseCost) {
+			t.Errorf("%s: first lease cost = %f, expected = %f", tc.name, entries[0].LeaseCost, tc.leaseCost)
+		}
+
+		// The liability and ROU asset should be fully amortized, and the total
+		// lease cost should equal the payments plus the ROU asset adjustments.
+		last := entries[len(entries)-1]
+		if !almostEqual(last.Liability, 0.0) {
+			t.Errorf("%s: ending liability = %f, expected = 0", tc.name, last.Liability)
+		}
+		if !almostEqual(last.ROUAsset, 0.0) {
+			t.Errorf("%s: ending ROU asset = %f, expected = 0", tc.name, last.ROUAsset)
+		}
+		totalCost := 0.0
+		expectedCost := tc.lease.InitialDirectCosts - tc.lease.Incentives
+		for _, entry := range entries {
+			totalCost += entry.LeaseCost
+			expectedCost += entry.Payment
+		}
+		if !almostEqual(totalCost, expectedCost) {
+			t.Errorf("%s: total lease cost = %f, expected = %f", tc.name, totalCost, expectedCost)
+		}
+	}
+}
+
+func TestScheduleModifiedTerm(t *testing.T) {
+	l := Lease{
+		Payments: []float64{1000, 1000, 1000},
+		Rate:     0.05,
+		Class:    Finance,
+		Modifications: []Modification{
+			{Period: 2, Payments: []float64{1200, 1200, 1200}, Rate: 0.06},
+		},
+	}
+	entries, err := l.Schedule()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("number of entries = %d, expected = 4", len(entries))
+	}
+	remeasured := InitialLiability([]float64{1200, 1200, 1200}, 0.06, false)
+	expected := remeasured - entries[0].Liability
+	if !almostEqual(entries[1].Remeasurement, expected) {
+		t.Errorf("remeasurement = %f, expected = %f", entries[1].Remeasurement, expected)
+	}
+
+	// A later modification may fall within the extended term, and the
+	// modifications may be given in any order.
+	l.Modifications = []Modification{
+		{Period: 4, Payments: []float64{900}, Rate: 0.06},
+		{Period: 2, Payments: []float64{1200, 1200, 1200}, Rate: 0.06},
+	}
+	entries, err = l.Schedule()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(entries) != 4 || entries[3].Payment != 900 {
+		t.Errorf("entries = %d with final payment %f, expected = 4 with 900", len(entries), entries[len(entries)-1].Payment)
+	}
+}
+
+func TestScheduleErrors(t *testing.T) {
+	testCases := []Lease{
+		{Payments: []float64{1000}, Rate: 0.05},
+		{Rate: 0.05, Class: Finance},
+		{Payments: []float64{1000}, Rate: 0.05, Class: Finance, Modifications: []Modification{{Period: 2, Payments: []float64{1}}}},
+		{Payments: []float64{1000}, Rate: 0.05, Class: Finance, Modifications: []Modification{{Period: 1}}},
+		// The period 5 modification falls past the term shortened in period 2.
+		{Payments: []float64{1000, 1000, 1000, 1000, 1000}, Rate: 0.05, Class: Finance, Modifications: []Modification{
+			{Period: 5, Payments: []float64{800}},
+			{Period: 2, Payments: []float64{1000, 1000}},
+		}},
+		{Payments: []float64{1000, 1000, 1000}, Rate: 0.05, Class: Finance, Modifications: []Modification{
+			{Period: 2, Payments: []float64{1100, 1100}},
+			{Period: 2, Payments: []float64{1200, 1200}},
+		}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.Schedule(); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
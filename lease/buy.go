@@ -0,0 +1,117 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package lease
+
+import (
+	"fmt"
+
+	"github.com/goinvest/fin/cf"
+	"github.com/goinvest/fin/depreciation"
+)
+
+// Analysis models the information needed to compare leasing an asset against
+// borrowing to buy the asset. Both alternatives are analyzed over the lease
+// term, after which the owned asset is assumed to be sold for its residual
+// value.
+type Analysis struct {
+	Price        float64             // Purchase price of the asset
+	Payment      float64             // Lease payment per period
+	Term         int                 // Number of periods in the lease
+	Advance      bool                // Lease payments made at start of period
+	Depreciation depreciation.Method // Depreciation method if owned
+	TaxRate      float64             // Marginal tax rate
+	CostOfDebt   float64             // Pre-tax cost of debt per period
+	Maintenance  float64             // Added pre-tax maintenance per period if owned
+	Residual     float64             // Pre-tax residual value at end of term
+}
+
+// Result contains the results of the lease versus buy analysis. The cost of
+// owning and the cost of leasing are the present values of the after-tax cash
+// outflows of each alternative, so the Net Advantage to Leasing (NAL) is
+// positive when leasing is the cheaper alternative.
+type Result struct {
+	OwnCashflows     []float64
+	LeaseCashflows   []float64
+	CostOfOwning     float64
+	CostOfLeasing    float64
+	NAL              float64
+	BreakevenPayment float64
+}
+
+// LeaseVsBuy calculates the Net Advantage to Leasing (NAL) by discounting the
+// after-tax cash flows of owning and of leasing at the after-tax cost of debt,
+// since a lease is a substitute for debt financing.
+//
+// NAL = PV cost of owning - PV cost of leasing
+//
+// The breakeven lease payment is the payment for which the NAL is zero (i.e.,
+// the maximum payment the lessee should be willing to make).
+func LeaseVsBuy(a Analysis) (Result, error) {
+	if a.Term < 1 {
+		return Result{}, fmt.Errorf("lease term must be at least one period")
+	}
+	if a.Depreciation == nil {
+		return Result{}, fmt.Errorf("depreciation method is required")
+	}
+	if len(a.Depreciation.Schedule(a.Price)) == 0 {
+		return Result{}, fmt.Errorf("depreciation method has an empty schedule")
+	}
+	k := a.CostOfDebt * (1 - a.TaxRate)
+
+	ownCFs := a.ownCashflows()
+	leaseCFs := a.leaseCashflows(a.Payment)
+	costOfOwning := -cf.NPV(ownCFs, k)
+	costOfLeasing := -cf.NPV(leaseCFs, k)
+
+	// The cost of leasing is linear in the lease payment, so the breakeven
+	// payment can be found using the cost of leasing for a payment of one.
+	unitCost := -cf.NPV(a.leaseCashflows(1.0), k)
+	if unitCost == 0.0 {
+		return Result{}, fmt.Errorf("cost of leasing does not depend on payment")
+	}
+
+	return Result{
+		OwnCashflows:     ownCFs,
+		LeaseCashflows:   leaseCFs,
+		CostOfOwning:     costOfOwning,
+		CostOfLeasing:    costOfLeasing,
+		NAL:              costOfOwning - costOfLeasing,
+		BreakevenPayment: costOfOwning / unitCost,
+	}, nil
+}
+
+// ownCashflows returns the after-tax cash flows for periods 0 through the
+// lease term from borrowing to buy the asset.
+func (a Analysis) ownCashflows() []float64 {
+	cashflows := make([]float64, a.Term+1)
+	cashflows[0] = -a.Price
+	schedule := a.Depreciation.Schedule(a.Price)
+	for t := 1; t <= a.Term; t++ {
+		cashflows[t] = -a.Maintenance * (1 - a.TaxRate)
+		if t <= len(schedule) {
+			cashflows[t] += schedule[t-1] * a.TaxRate
+		}
+	}
+	// Any gain (or loss) on the sale of the asset relative to its book value
+	// is taxed at the marginal rate.
+	bookValue := depreciation.BookValue(a.Price, schedule, a.Term)
+	cashflows[a.Term] += a.Residual - (a.Residual-bookValue)*a.TaxRate
+	return cashflows
+}
+
+// leaseCashflows returns the after-tax cash flows for periods 0 through the
+// lease term from leasing the asset for the given payment.
+func (a Analysis) leaseCashflows(payment float64) []float64 {
+	cashflows := make([]float64, a.Term+1)
+	for i := 0; i < a.Term; i++ {
+		t := i + 1
+		if a.Advance {
+			t = i
+		}
+		cashflows[t] -= payment * (1 - a.TaxRate)
+	}
+	return cashflows
+}
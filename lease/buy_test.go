@@ -0,0 +1,102 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package lease
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goinvest/fin/depreciation"
+)
+
+const tolerance = 0.000001
+
+func TestLeaseVsBuy(t *testing.T) {
+	macrs3, err := depreciation.NewMACRS(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		analysis  Analysis
+		owning    float64
+		leasing   float64
+		nal       float64
+		breakeven float64
+	}{
+		{
+			Analysis{
+				Price:        10000,
+				Payment:      2800,
+				Term:         4,
+				Advance:      true,
+				Depreciation: macrs3,
+				TaxRate:      0.40,
+				CostOfDebt:   0.10,
+				Residual:     2000,
+			},
+			5477.171060, 6170.660075, -693.489015, 2485.322280,
+		},
+		{
+			Analysis{
+				Price:        5000,
+				Payment:      1200,
+				Term:         5,
+				Depreciation: depreciation.StraightLine{Life: 5, Salvage: 500},
+				TaxRate:      0.35,
+				CostOfDebt:   0.08,
+				Maintenance:  100,
+				Residual:     500,
+			},
+			3535.535540, 3358.403031, 177.132509, 1263.291692,
+		},
+	}
+	for _, tc := range testCases {
+		got, err := LeaseVsBuy(tc.analysis)
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		if !almostEqual(got.CostOfOwning, tc.owning) {
+			t.Errorf("cost of owning = %f, expected = %f", got.CostOfOwning, tc.owning)
+		}
+		if !almostEqual(got.CostOfLeasing, tc.leasing) {
+			t.Errorf("cost of leasing = %f, expected = %f", got.CostOfLeasing, tc.leasing)
+		}
+		if !almostEqual(got.NAL, tc.nal) {
+			t.Errorf("NAL = %f, expected = %f", got.NAL, tc.nal)
+		}
+		if !almostEqual(got.BreakevenPayment, tc.breakeven) {
+			t.Errorf("breakeven payment = %f, expected = %f", got.BreakevenPayment, tc.breakeven)
+		}
+
+		// Leasing at the breakeven payment should have no advantage.
+		a := tc.analysis
+		a.Payment = got.BreakevenPayment
+		breakeven, _ := LeaseVsBuy(a)
+		if !almostEqual(breakeven.NAL, 0.0) {
+			t.Errorf("NAL at breakeven payment = %f, expected = 0", breakeven.NAL)
+		}
+	}
+}
+
+func TestLeaseVsBuyErrors(t *testing.T) {
+	testCases := []Analysis{
+		{Price: 1000, Payment: 100, Term: 0, Depreciation: depreciation.StraightLine{Life: 5}},
+		{Price: 1000, Payment: 100, Term: 5},
+		{Price: 1000, Payment: 100, Term: 5, Depreciation: depreciation.MACRS{}},
+		{Price: 1000, Payment: 100, Term: 5, Depreciation: depreciation.StraightLine{Life: -1}},
+		{Price: 1000, Payment: 100, Term: 5, Depreciation: depreciation.DecliningBalance{Life: -1, Factor: 2}},
+		{Price: 1000, Payment: 100, Term: 5, TaxRate: 1.0, Depreciation: depreciation.StraightLine{Life: 5}},
+	}
+	for _, tc := range testCases {
+		if _, err := LeaseVsBuy(tc); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) < tolerance
+}
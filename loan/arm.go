@@ -0,0 +1,145 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package loan
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// ARM models an adjustable-rate (i.e., floating-rate) amortizing loan. The
+// loan pays the InitialRate for the first FixedPeriods periods and then resets
+// every ResetPeriods periods to the index plus the Margin, subject to the caps
+// and floors. All rates are annual rates, with PeriodsPerYear payments per
+// year (defaulting to 12). For example, a 5/1 ARM with monthly payments has
+// 60 FixedPeriods and 12 ResetPeriods.
+//
+// The Index contains the annual index rate for each period, where Index[t-1]
+// is the index observed for a reset in period t. A single value gives a
+// deterministic index, and if the Index has fewer values than periods, the
+// last value is used for the remaining periods.
+//
+// The PeriodicCap and PeriodicFloor limit the increase and decrease in the
+// rate at each reset, while the LifetimeCap and LifetimeFloor limit the rate
+// over the life of the loan. A zero cap means the rate is uncapped, and the
+// rate never falls below the LifetimeFloor, which defaults to zero.
+type ARM struct {
+	Principal      float64
+	Term           int
+	PeriodsPerYear int
+	InitialRate    float64
+	FixedPeriods   int
+	ResetPeriods   int
+	Margin         float64
+	Index          []float64
+	PeriodicCap    float64
+	PeriodicFloor  float64
+	LifetimeCap    float64
+	LifetimeFloor  float64
+	Fees           float64 // Upfront fees and points paid by the borrower
+}
+
+// Payment contains a single period of a loan schedule.
+type Payment struct {
+	Period    int
+	Rate      float64 // Annual rate for the period
+	Payment   float64
+	Interest  float64
+	Principal float64
+	Balance   float64 // Balance at the end of the period
+	Reset     bool    // Rate reset and payment recast in this period
+}
+
+// Schedule generates the payment schedule of the loan, recasting the payment
+// at each reset to fully amortize the remaining balance over the remaining
+// term at the new rate.
+func (a ARM) Schedule() ([]Payment, error) {
+	if a.Term < 1 {
+		return nil, fmt.Errorf("loan term must be at least one period")
+	}
+	if a.FixedPeriods < a.Term && a.ResetPeriods < 1 {
+		return nil, fmt.Errorf("reset periods must be at least one period")
+	}
+	if a.FixedPeriods < a.Term && len(a.Index) == 0 {
+		return nil, fmt.Errorf("need index rates for resets")
+	}
+	ppy := float64(a.periodsPerYear())
+
+	schedule := make([]Payment, a.Term)
+	balance := a.Principal
+	rate := a.InitialRate
+	payment := -cf.PMT(rate/ppy, float64(a.Term), balance, 0.0, false)
+	for i := range schedule {
+		t := i + 1
+		p := Payment{Period: t}
+		if t > a.FixedPeriods && (t-a.FixedPeriods-1)%a.ResetPeriods == 0 {
+			rate = a.resetRate(rate, a.index(t))
+			payment = -cf.PMT(rate/ppy, float64(a.Term-i), balance, 0.0, false)
+			p.Reset = true
+		}
+		p.Rate = rate
+		p.Interest = balance * rate / ppy
+		p.Payment = payment
+		p.Principal = payment - p.Interest
+		balance -= p.Principal
+		p.Balance = balance
+		schedule[i] = p
+	}
+	return schedule, nil
+}
+
+// EffectiveCost calculates the annual effective cost of the loan to the
+// borrower including the upfront fees, which is the IRR of the net loan
+// proceeds and the payments compounded over the periods per year.
+func (a ARM) EffectiveCost() (float64, error) {
+	schedule, err := a.Schedule()
+	if err != nil {
+		return math.NaN(), err
+	}
+	cashflows := make([]float64, len(schedule)+1)
+	cashflows[0] = a.Principal - a.Fees
+	for i, p := range schedule {
+		cashflows[i+1] = -p.Payment
+	}
+	ppy := float64(a.periodsPerYear())
+	irr, err := cf.IRR(cashflows, cf.IRROptions{InitialGuess: a.InitialRate / ppy})
+	if err != nil {
+		return math.NaN(), err
+	}
+	return math.Pow(1+irr, ppy) - 1, nil
+}
+
+// resetRate calculates the new rate from the fully indexed rate (index plus
+// margin) applying the periodic and lifetime caps and floors.
+func (a ARM) resetRate(previous, index float64) float64 {
+	rate := index + a.Margin
+	if a.PeriodicCap != 0.0 {
+		rate = math.Min(rate, previous+a.PeriodicCap)
+	}
+	if a.PeriodicFloor != 0.0 {
+		rate = math.Max(rate, previous-a.PeriodicFloor)
+	}
+	if a.LifetimeCap != 0.0 {
+		rate = math.Min(rate, a.LifetimeCap)
+	}
+	return math.Max(rate, a.LifetimeFloor)
+}
+
+func (a ARM) index(period int) float64 {
+	if period > len(a.Index) {
+		return a.Index[len(a.Index)-1]
+	}
+	return a.Index[period-1]
+}
+
+func (a ARM) periodsPerYear() int {
+	if a.PeriodsPerYear == 0 {
+		return 12
+	}
+	return a.PeriodsPerYear
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package loan
+
+import (
+	"math"
+	"testing"
+)
+
+const tolerance = 0.000001
+
+func TestARMSchedule(t *testing.T) {
+	testCases := []struct {
+		name     string
+		arm      ARM
+		rates    []float64
+		payments []float64
+	}{
+		{
+			"caps",
+			ARM{
+				Principal:      1000,
+				Term:           3,
+				PeriodsPerYear: 1,
+				InitialRate:    0.05,
+				FixedPeriods:   1,
+				ResetPeriods:   1,
+				Margin:         0.02,
+				Index:          []float64{0.0, 0.06, 0.08},
+				PeriodicCap:    0.02,
+				LifetimeCap:    0.095,
+			},
+			[]float64{0.05, 0.07, 0.09},
+			[]float64{367.208565, 377.646335, 384.705145},
+		},
+		{
+			"floors",
+			ARM{
+				Principal:      1000,
+				Term:           4,
+				PeriodsPerYear: 1,
+				InitialRate:    0.06,
+				FixedPeriods:   1,
+				ResetPeriods:   1,
+				Margin:         0.02,
+				Index:          []float64{0.0, 0.02},
+				PeriodicFloor:  0.015,
+				LifetimeFloor:  0.035,
+			},
+			[]float64{0.06, 0.045, 0.04, 0.04},
+			nil,
+		},
+		{
+			"deterministic_index",
+			ARM{
+				Principal:    100000,
+				Term:         360,
+				InitialRate:  0.04,
+				FixedPeriods: 60,
+				ResetPeriods: 12,
+				Margin:       0.0275,
+				Index:        []float64{0.03},
+			},
+			nil,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		schedule, err := tc.arm.Schedule()
+		if err != nil {
+			t.Errorf("%s: expected no error, got: %s", tc.name, err)
+			continue
+		}
+		for i, rate := range tc.rates {
+			if !almostEqual(schedule[i].Rate, rate) {
+				t.Errorf("%s: period %d rate = %f, expected = %f", tc.name, i+1, schedule[i].Rate, rate)
+			}
+		}
+		for i, payment := range tc.payments {
+			if !almostEqual(schedule[i].Payment, payment) {
+				t.Errorf("%s: period %d payment = %f, expected = %f", tc.name, i+1, schedule[i].Payment, payment)
+			}
+		}
+		for _, p := range schedule {
+			expectReset := p.Period > tc.arm.FixedPeriods && (p.Period-tc.arm.FixedPeriods-1)%tc.arm.ResetPeriods == 0
+			if p.Reset != expectReset {
+				t.Errorf("%s: period %d reset = %t, expected = %t", tc.name, p.Period, p.Reset, expectReset)
+			}
+		}
+		if last := schedule[len(schedule)-1]; math.Abs(last.Balance) > 0.0001 {
+			t.Errorf("%s: ending balance = %f, expected = 0", tc.name, last.Balance)
+		}
+	}
+}
+
+func TestARMEffectiveCost(t *testing.T) {
+	fixed := ARM{Principal: 100000, Term: 360, InitialRate: 0.06, FixedPeriods: 360}
+	got, err := fixed.EffectiveCost()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if !almostEqual(got, 0.061678) {
+		t.Errorf("effective cost = %f, expected = %f", got, 0.061678)
+	}
+
+	// Upfront fees increase the effective cost.
+	fixed.Fees = 2000
+	withFees, err := fixed.EffectiveCost()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if withFees <= got {
+		t.Errorf("effective cost with fees = %f, expected > %f", withFees, got)
+	}
+}
+
+func TestARMErrors(t *testing.T) {
+	testCases := []ARM{
+		{Principal: 1000, InitialRate: 0.05},
+		{Principal: 1000, Term: 12, InitialRate: 0.05, Index: []float64{0.03}},
+		{Principal: 1000, Term: 12, InitialRate: 0.05, ResetPeriods: 12},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.Schedule(); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+		if _, err := tc.EffectiveCost(); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) < tolerance
+}
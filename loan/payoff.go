@@ -0,0 +1,244 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package loan
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/goinvest/fin/daycount"
+)
+
+// Debt models a revolving or installment debt with an annual percentage rate
+// (APR) compounded monthly and a required minimum monthly payment.
+type Debt struct {
+	Name           string
+	Balance        float64
+	APR            float64
+	MinimumPayment float64
+}
+
+// Strategy is the order in which extra payments are applied to debts.
+type Strategy int
+
+// Debt repayment strategies. Avalanche targets the highest APR first, which
+// minimizes the total interest. Snowball targets the smallest balance first,
+// which pays off individual debts sooner. Custom targets the debts in the
+// order given.
+const (
+	Avalanche Strategy = 1
+	Snowball  Strategy = 2
+	Custom    Strategy = 3
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case Avalanche:
+		return "avalanche"
+	case Snowball:
+		return "snowball"
+	case Custom:
+		return "custom"
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+// Planner models paying off the Debts with a total monthly Budget. Each month
+// the minimum payment is made on every debt, and the rest of the budget,
+// including the minimum payments freed up by paid-off debts, goes to the
+// target debt. If the Start date is set, payoff dates are the Start plus the
+// number of months. Simulations stop with an error after MaxMonths months,
+// which defaults to 1200.
+type Planner struct {
+	Debts     []Debt
+	Budget    float64
+	Start     time.Time
+	MaxMonths int
+}
+
+// DebtPayoff contains the monthly payment schedule for a single debt.
+type DebtPayoff struct {
+	Name          string
+	Schedule      []Payment
+	Months        int
+	PayoffDate    time.Time
+	TotalInterest float64
+	TotalPaid     float64
+}
+
+// PayoffPlan contains the result of simulating a repayment strategy. The
+// Months is the number of months until every debt is paid off.
+type PayoffPlan struct {
+	Strategy      Strategy
+	Debts         []DebtPayoff
+	Months        int
+	PayoffDate    time.Time
+	TotalInterest float64
+	TotalPaid     float64
+}
+
+// Simulate simulates the repayment strategy month by month. The Custom
+// strategy requires the order of the debt names, which must name every debt.
+func (p Planner) Simulate(s Strategy, order ...string) (PayoffPlan, error) {
+	minimums := 0.0
+	for _, d := range p.Debts {
+		if d.Balance < 0.0 || d.APR < 0.0 || d.MinimumPayment < 0.0 {
+			return PayoffPlan{}, fmt.Errorf("negative balance, APR, or minimum payment for debt %s", d.Name)
+		}
+		minimums += d.MinimumPayment
+	}
+	if p.Budget < minimums {
+		return PayoffPlan{}, fmt.Errorf("budget %.2f is less than the minimum payments %.2f", p.Budget, minimums)
+	}
+	targets, err := p.order(s, order)
+	if err != nil {
+		return PayoffPlan{}, err
+	}
+	maxMonths := p.MaxMonths
+	if maxMonths == 0 {
+		maxMonths = 1200
+	}
+
+	plan := PayoffPlan{Strategy: s, Debts: make([]DebtPayoff, len(p.Debts))}
+	balances := make([]float64, len(p.Debts))
+	for i, d := range p.Debts {
+		plan.Debts[i].Name = d.Name
+		balances[i] = d.Balance
+	}
+	for month := 1; !paidOff(balances); month++ {
+		if month > maxMonths {
+			return PayoffPlan{}, fmt.Errorf("debts not paid off after %d months", maxMonths)
+		}
+		payments := make([]Payment, len(p.Debts))
+		available := p.Budget
+		for i, d := range p.Debts {
+			if balances[i] <= 0.0 {
+				continue
+			}
+			interest := balances[i] * d.APR / 12
+			payments[i] = Payment{Period: month, Rate: d.APR, Interest: interest}
+			balances[i] += interest
+			pay := math.Min(d.MinimumPayment, balances[i])
+			payments[i].Payment = pay
+			balances[i] -= pay
+			available -= pay
+		}
+		for _, i := range targets {
+			if available <= 0.0 {
+				break
+			}
+			if balances[i] <= 0.0 {
+				continue
+			}
+			pay := math.Min(available, balances[i])
+			payments[i].Payment += pay
+			balances[i] -= pay
+			available -= pay
+		}
+		for i := range p.Debts {
+			if payments[i].Period == 0 {
+				continue
+			}
+			if balances[i] < 1e-9 {
+				balances[i] = 0.0
+			}
+			payments[i].Principal = payments[i].Payment - payments[i].Interest
+			payments[i].Balance = balances[i]
+			dp := &plan.Debts[i]
+			dp.Schedule = append(dp.Schedule, payments[i])
+			dp.TotalInterest += payments[i].Interest
+			dp.TotalPaid += payments[i].Payment
+			if balances[i] == 0.0 {
+				dp.Months = month
+			}
+		}
+		plan.Months = month
+	}
+
+	for i := range plan.Debts {
+		dp := &plan.Debts[i]
+		plan.TotalInterest += dp.TotalInterest
+		plan.TotalPaid += dp.TotalPaid
+		if !p.Start.IsZero() {
+			dp.PayoffDate = daycount.AddMonths(p.Start, dp.Months)
+		}
+	}
+	if !p.Start.IsZero() {
+		plan.PayoffDate = daycount.AddMonths(p.Start, plan.Months)
+	}
+	return plan, nil
+}
+
+// Consolidate simulates replacing the debts with a single consolidation loan
+// at the APR, adding the upfront fees to the balance, and paying the loan off
+// with the same monthly budget.
+func (p Planner) Consolidate(apr, fees float64) (PayoffPlan, error) {
+	balance := fees
+	for _, d := range p.Debts {
+		balance += d.Balance
+	}
+	consolidated := p
+	consolidated.Debts = []Debt{{Name: "Consolidation", Balance: balance, APR: apr}}
+	return consolidated.Simulate(Custom, "Consolidation")
+}
+
+// order returns the indices of the debts in the order they are targeted for
+// extra payments.
+func (p Planner) order(s Strategy, names []string) ([]int, error) {
+	targets := make([]int, len(p.Debts))
+	for i := range targets {
+		targets[i] = i
+	}
+	switch s {
+	case Avalanche:
+		sort.SliceStable(targets, func(a, b int) bool {
+			da, db := p.Debts[targets[a]], p.Debts[targets[b]]
+			if da.APR != db.APR {
+				return da.APR > db.APR
+			}
+			return da.Balance < db.Balance
+		})
+	case Snowball:
+		sort.SliceStable(targets, func(a, b int) bool {
+			da, db := p.Debts[targets[a]], p.Debts[targets[b]]
+			if da.Balance != db.Balance {
+				return da.Balance < db.Balance
+			}
+			return da.APR > db.APR
+		})
+	case Custom:
+		if len(names) != len(p.Debts) {
+			return nil, fmt.Errorf("custom order has %d debts, expected %d", len(names), len(p.Debts))
+		}
+		index := make(map[string]int, len(p.Debts))
+		for i, d := range p.Debts {
+			index[d.Name] = i
+		}
+		seen := make(map[string]bool, len(names))
+		for i, name := range names {
+			j, ok := index[name]
+			if !ok || seen[name] {
+				return nil, fmt.Errorf("unknown or repeated debt %s in custom order", name)
+			}
+			seen[name] = true
+			targets[i] = j
+		}
+	default:
+		return nil, fmt.Errorf("unknown strategy %s", s)
+	}
+	return targets, nil
+}
+
+func paidOff(balances []float64) bool {
+	for _, b := range balances {
+		if b > 0.0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package loan
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/goinvest/fin/cf"
+	"github.com/goinvest/fin/daycount"
+)
+
+var testDebts = []Debt{
+	{"Card A", 5000, 0.24, 100},
+	{"Card B", 1000, 0.18, 25},
+	{"Car", 8000, 0.06, 200},
+}
+
+func TestPlannerSimulateSingleDebt(t *testing.T) {
+	p := Planner{Debts: []Debt{{"Card", 1000, 0.12, 0}}, Budget: 100}
+	plan, err := p.Simulate(Avalanche)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := int(math.Ceil(cf.NPER(0.01, -100, 1000, 0, false))); plan.Months != expected {
+		t.Errorf("months = %d, expected = %d", plan.Months, expected)
+	}
+	if !almostEqual(plan.TotalPaid-plan.TotalInterest, 1000) {
+		t.Errorf("principal paid = %f, expected = %f", plan.TotalPaid-plan.TotalInterest, 1000.0)
+	}
+	// The balance after the first payment matches the annuity balance.
+	if expected := -cf.FV(0.01, 1, -100, 1000, false); !almostEqual(plan.Debts[0].Schedule[0].Balance, expected) {
+		t.Errorf("balance = %f, expected = %f", plan.Debts[0].Schedule[0].Balance, expected)
+	}
+}
+
+func TestPlannerSimulateStrategies(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)
+	p := Planner{Debts: testDebts, Budget: 600, Start: start}
+	testCases := []struct {
+		strategy Strategy
+		order    []string
+		first    string
+	}{
+		{Avalanche, nil, "Card A"},
+		{Snowball, nil, "Card B"},
+		{Custom, []string{"Car", "Card B", "Card A"}, "Car"},
+	}
+	plans := make(map[Strategy]PayoffPlan)
+	for _, tc := range testCases {
+		plan, err := p.Simulate(tc.strategy, tc.order...)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.strategy, err)
+		}
+		plans[tc.strategy] = plan
+
+		// The first debt targeted is the first paid off.
+		first := plan.Debts[0]
+		for _, d := range plan.Debts {
+			if d.Months < first.Months {
+				first = d
+			}
+		}
+		if first.Name != tc.first {
+			t.Errorf("%s: first paid off = %s, expected = %s", tc.strategy, first.Name, tc.first)
+		}
+
+		totalInterest := 0.0
+		for i, d := range plan.Debts {
+			principal := 0.0
+			for _, payment := range d.Schedule {
+				principal += payment.Principal
+			}
+			if !almostEqual(principal, testDebts[i].Balance) {
+				t.Errorf("%s: %s principal = %f, expected = %f", tc.strategy, d.Name, principal, testDebts[i].Balance)
+			}
+			if last := d.Schedule[len(d.Schedule)-1]; last.Balance != 0.0 || last.Period != d.Months {
+				t.Errorf("%s: %s ends with balance %f in month %d", tc.strategy, d.Name, last.Balance, last.Period)
+			}
+			if expected := daycount.AddMonths(start, d.Months); !d.PayoffDate.Equal(expected) {
+				t.Errorf("%s: %s payoff date = %s, expected = %s", tc.strategy, d.Name, d.PayoffDate, expected)
+			}
+			totalInterest += d.TotalInterest
+		}
+		if !almostEqual(plan.TotalInterest, totalInterest) {
+			t.Errorf("%s: total interest = %f, expected = %f", tc.strategy, plan.TotalInterest, totalInterest)
+		}
+		if expected := daycount.AddMonths(start, plan.Months); !plan.PayoffDate.Equal(expected) {
+			t.Errorf("%s: payoff date = %s, expected = %s", tc.strategy, plan.PayoffDate, expected)
+		}
+	}
+
+	// The avalanche minimizes the total interest.
+	for _, s := range []Strategy{Snowball, Custom} {
+		if plans[Avalanche].TotalInterest > plans[s].TotalInterest {
+			t.Errorf("avalanche interest = %f, expected no more than %s interest = %f",
+				plans[Avalanche].TotalInterest, s, plans[s].TotalInterest)
+		}
+	}
+
+	// Consolidating at a lower rate saves interest.
+	consolidated, err := p.Consolidate(0.08, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if consolidated.TotalInterest >= plans[Avalanche].TotalInterest {
+		t.Errorf("consolidated interest = %f, expected less than %f", consolidated.TotalInterest, plans[Avalanche].TotalInterest)
+	}
+	if !almostEqual(consolidated.TotalPaid-consolidated.TotalInterest, 14200) {
+		t.Errorf("consolidated principal = %f, expected = %f", consolidated.TotalPaid-consolidated.TotalInterest, 14200.0)
+	}
+}
+
+func TestPlannerSimulateErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		planner  Planner
+		strategy Strategy
+		order    []string
+	}{
+		{"budget", Planner{Debts: testDebts, Budget: 300}, Avalanche, nil},
+		{"negative_balance", Planner{Debts: []Debt{{"Card", -1, 0.1, 0}}, Budget: 300}, Avalanche, nil},
+		{"strategy", Planner{Debts: testDebts, Budget: 600}, Strategy(9), nil},
+		{"custom_missing", Planner{Debts: testDebts, Budget: 600}, Custom, []string{"Car", "Card A"}},
+		{"custom_unknown", Planner{Debts: testDebts, Budget: 600}, Custom, []string{"Car", "Card A", "Boat"}},
+		{"custom_repeated", Planner{Debts: testDebts, Budget: 600}, Custom, []string{"Car", "Car", "Card A"}},
+		{"never_paid_off", Planner{Debts: []Debt{{"Card", 10000, 0.24, 50}}, Budget: 100}, Avalanche, nil},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.planner.Simulate(tc.strategy, tc.order...); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package mbs
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Pool models a pool of fixed-rate level-payment mortgages. The WAC is the
+// gross weighted average coupon paid by the borrowers and the NetCoupon is the
+// pass-through rate paid to investors after servicing and guarantee fees; if
+// the NetCoupon is zero, the WAC is used. The WAM is the weighted average
+// remaining maturity in months and the Age is the number of months since
+// origination. Defaulted balances recover the balance less the Severity after
+// the RecoveryLag in months. Nil prepayment or default speeds mean no
+// prepayments or defaults.
+type Pool struct {
+	Balance     float64
+	WAC         float64
+	NetCoupon   float64
+	WAM         int
+	Age         int
+	Prepayment  Speed
+	Default     Speed
+	Severity    float64
+	RecoveryLag int
+}
+
+// Month contains the projected pool cash flows for a single month.
+type Month struct {
+	Month              int
+	Balance            float64 // Beginning balance
+	SMM                float64
+	MDR                float64
+	Default            float64
+	Payment            float64 // Scheduled mortgage payment
+	Interest           float64 // Net interest paid to investors
+	ScheduledPrincipal float64
+	Prepayment         float64
+	Recovery           float64
+	Loss               float64
+	EndingBalance      float64
+	Cashflow           float64 // Cash flow to investors
+}
+
+// Project projects the monthly cash flows of the pool until the balance is
+// paid off and all defaults are recovered. Each month the defaults are
+// removed from the beginning balance first, then the scheduled payment is
+// made on the performing balance, and then the prepayments are made from the
+// performing balance remaining after scheduled principal.
+func (p Pool) Project() ([]Month, error) {
+	if p.WAM < 1 {
+		return nil, fmt.Errorf("weighted average maturity must be at least one month")
+	}
+	if p.Severity < 0.0 || p.Severity > 1.0 {
+		return nil, fmt.Errorf("loss severity %f must be between 0 and 1", p.Severity)
+	}
+	if p.RecoveryLag < 0 {
+		return nil, fmt.Errorf("recovery lag %d must not be negative", p.RecoveryLag)
+	}
+	gross := p.WAC / 12
+	net := p.NetCoupon / 12
+	if p.NetCoupon == 0.0 {
+		net = gross
+	}
+
+	defaults := make([]float64, p.WAM+1)
+	months := make([]Month, p.WAM+p.RecoveryLag)
+	balance := p.Balance
+	for i := range months {
+		t := i + 1
+		m := Month{Month: t, Balance: balance}
+		if t <= p.WAM && balance > 0.0 {
+			if p.Default != nil {
+				m.MDR = p.Default.Monthly(p.Age + t)
+			}
+			if p.Prepayment != nil {
+				m.SMM = p.Prepayment.Monthly(p.Age + t)
+			}
+			m.Default = balance * m.MDR
+			defaults[t] = m.Default
+			performing := balance - m.Default
+			m.Payment = -cf.PMT(gross, float64(p.WAM-t+1), performing, 0.0, false)
+			m.ScheduledPrincipal = m.Payment - performing*gross
+			m.Interest = performing * net
+			m.Prepayment = (performing - m.ScheduledPrincipal) * m.SMM
+			balance = performing - m.ScheduledPrincipal - m.Prepayment
+		}
+		if d := t - p.RecoveryLag; d >= 1 && d <= p.WAM {
+			m.Recovery = defaults[d] * (1 - p.Severity)
+			m.Loss = defaults[d] * p.Severity
+		}
+		m.EndingBalance = math.Max(balance, 0.0)
+		m.Cashflow = m.Interest + m.ScheduledPrincipal + m.Prepayment + m.Recovery
+		months[i] = m
+	}
+	return months, nil
+}
+
+// Cashflows returns the cash flows to investors as a cash flow vector where
+// period 0 (the settlement date) has no cash flow, so the vector can be priced
+// using cf.NPV with a monthly yield.
+func Cashflows(months []Month) []float64 {
+	cashflows := make([]float64, len(months)+1)
+	for i, m := range months {
+		cashflows[i+1] = m.Cashflow
+	}
+	return cashflows
+}
+
+// Price calculates the price of the pool cash flows at the annual yield
+// compounded monthly.
+func Price(months []Month, yield float64) float64 {
+	return cf.NPV(Cashflows(months), yield/12)
+}
+
+// Yield calculates the annual yield compounded monthly for which the present
+// value of the pool cash flows equals the price. The monthly yield is found
+// using cf.IRR.
+func Yield(months []Month, price float64) (float64, error) {
+	cashflows := Cashflows(months)
+	cashflows[0] = -price
+	monthly, err := cf.IRR(cashflows, cf.IRROptions{InitialGuess: 0.005})
+	if err != nil {
+		return math.NaN(), err
+	}
+	return monthly * 12, nil
+}
+
+// BondEquivalentYield converts a monthly yield into a bond-equivalent yield
+// (i.e., compounded semiannually), which is used to compare mortgage-backed
+// securities with Treasury securities.
+//
+// BEY = 2 * [(1 + monthly yield)^6 - 1]
+func BondEquivalentYield(monthly float64) float64 {
+	return 2 * (math.Pow(1+monthly, 6) - 1)
+}
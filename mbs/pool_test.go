@@ -0,0 +1,128 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package mbs
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProjectPSA(t *testing.T) {
+	// Example from Fabozzi, The Handbook of Fixed Income Securities: a $400
+	// million pass-through with a 7.5% pass-through rate, an 8.125% WAC, a 357
+	// month WAM, and 100% PSA.
+	p := Pool{
+		Balance:    400000000,
+		WAC:        0.08125,
+		NetCoupon:  0.075,
+		WAM:        357,
+		Age:        3,
+		Prepayment: PSA(100),
+	}
+	months, err := p.Project()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	m := months[0]
+	expected := []struct {
+		label string
+		got   float64
+		want  float64
+	}{
+		{"payment", m.Payment, 2975868},
+		{"interest", m.Interest, 2500000},
+		{"scheduled principal", m.ScheduledPrincipal, 267535},
+		{"prepayment", m.Prepayment, 267470},
+		{"cash flow", m.Cashflow, 3035005},
+	}
+	for _, e := range expected {
+		if math.Abs(e.got-e.want) > 1.0 {
+			t.Errorf("month 1 %s = %f, expected = %f", e.label, e.got, e.want)
+		}
+	}
+	assertPaidOff(t, p, months)
+}
+
+func TestProjectDefaults(t *testing.T) {
+	p := Pool{
+		Balance:     1000000,
+		WAC:         0.06,
+		WAM:         120,
+		Prepayment:  CPR(0.10),
+		Default:     CDR(0.02),
+		Severity:    0.40,
+		RecoveryLag: 12,
+	}
+	months, err := p.Project()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if len(months) != 132 {
+		t.Errorf("number of months = %d, expected = 132", len(months))
+	}
+	if months[11].Recovery != 0.0 || months[12].Recovery == 0.0 {
+		t.Errorf("first recovery expected in month 13, got %f in month 12 and %f in month 13",
+			months[11].Recovery, months[12].Recovery)
+	}
+	if !almostEqual(months[12].Recovery, months[0].Default*0.60) {
+		t.Errorf("month 13 recovery = %f, expected = %f", months[12].Recovery, months[0].Default*0.60)
+	}
+	assertPaidOff(t, p, months)
+}
+
+func TestPriceYield(t *testing.T) {
+	p := Pool{Balance: 1000000, WAC: 0.06, WAM: 360, Prepayment: PSA(150)}
+	months, err := p.Project()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	// Without servicing fees the pool is worth par at its coupon.
+	if price := Price(months, 0.06); math.Abs(price-1000000) > 0.01 {
+		t.Errorf("price at coupon = %f, expected = 1000000", price)
+	}
+	yield, err := Yield(months, 980000)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if yield <= 0.06 {
+		t.Errorf("yield at a discount = %f, expected > 0.06", yield)
+	}
+	if price := Price(months, yield); math.Abs(price-980000) > 0.01 {
+		t.Errorf("price at yield = %f, expected = 980000", price)
+	}
+	if got := BondEquivalentYield(0.005); !almostEqual(got, 0.060755) {
+		t.Errorf("bond-equivalent yield = %f, expected = 0.060755", got)
+	}
+}
+
+func TestProjectErrors(t *testing.T) {
+	testCases := []Pool{
+		{Balance: 1000, WAC: 0.06},
+		{Balance: 1000, WAC: 0.06, WAM: 12, Severity: 1.5},
+		{Balance: 1000, WAC: 0.06, WAM: 12, RecoveryLag: -20},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.Project(); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
+
+// assertPaidOff checks that the principal, recoveries, and losses account for
+// the entire balance of the pool.
+func assertPaidOff(t *testing.T, p Pool, months []Month) {
+	t.Helper()
+	total := 0.0
+	for _, m := range months {
+		total += m.ScheduledPrincipal + m.Prepayment + m.Recovery + m.Loss
+	}
+	if math.Abs(total-p.Balance) > 0.01 {
+		t.Errorf("principal, recoveries, and losses = %f, expected = %f", total, p.Balance)
+	}
+	if last := months[len(months)-1]; math.Abs(last.EndingBalance) > 0.01 {
+		t.Errorf("ending balance = %f, expected = 0", last.EndingBalance)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package mbs
+
+import (
+	"math"
+)
+
+// Speed is the interface that wraps the Monthly method.
+//
+// Monthly returns the monthly rate (e.g., the Single Monthly Mortality) for
+// the given loan age in months, where the first month after origination has an
+// age of 1.
+type Speed interface {
+	Monthly(age int) float64
+}
+
+// CPR is a constant Conditional Prepayment Rate, which is the annualized
+// fraction of the outstanding balance prepaid each month.
+type CPR float64
+
+// Monthly implements the Speed interface by converting the CPR into the
+// Single Monthly Mortality (SMM).
+func (c CPR) Monthly(age int) float64 {
+	return SMM(float64(c))
+}
+
+// CDR is a constant Conditional Default Rate, which is the annualized
+// fraction of the outstanding balance defaulting each month.
+type CDR float64
+
+// Monthly implements the Speed interface by converting the CDR into the
+// Monthly Default Rate (MDR).
+func (c CDR) Monthly(age int) float64 {
+	return SMM(float64(c))
+}
+
+// PSA is a prepayment speed as a percentage of the Public Securities
+// Association (PSA) benchmark (e.g., 100 for 100% PSA). The benchmark CPR
+// increases by 0.2% per month until reaching 6% in month 30 and stays at 6%
+// thereafter.
+type PSA float64
+
+// Monthly implements the Speed interface by converting the CPR for the loan
+// age into the Single Monthly Mortality (SMM).
+func (p PSA) Monthly(age int) float64 {
+	return SMM(PSACPR(float64(p), age))
+}
+
+// PSACPR calculates the CPR for the loan age in months at the given PSA
+// speed.
+func PSACPR(speed float64, age int) float64 {
+	cpr := 0.06 * math.Min(float64(age)/30, 1.0)
+	return cpr * speed / 100
+}
+
+// SMM converts the annual Conditional Prepayment Rate (CPR) into the Single
+// Monthly Mortality (SMM).
+//
+// SMM = 1 - (1 - CPR)^(1/12)
+func SMM(cpr float64) float64 {
+	return 1 - math.Pow(1-cpr, 1.0/12)
+}
+
+// CPRFromSMM converts the Single Monthly Mortality (SMM) into the annual
+// Conditional Prepayment Rate (CPR).
+//
+// CPR = 1 - (1 - SMM)^12
+func CPRFromSMM(smm float64) float64 {
+	return 1 - math.Pow(1-smm, 12)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package mbs
+
+import (
+	"math"
+	"testing"
+)
+
+const tolerance = 0.000001
+
+func TestSMM(t *testing.T) {
+	testCases := []struct {
+		cpr float64
+		smm float64
+	}{
+		{0.06, 0.005143},
+		{0.008, 0.000669},
+		{0.0, 0.0},
+	}
+	for _, tc := range testCases {
+		if got := SMM(tc.cpr); math.Abs(got-tc.smm) > 0.000001 {
+			t.Errorf("SMM for %f CPR = %f, expected = %f", tc.cpr, got, tc.smm)
+		}
+		if got := CPRFromSMM(SMM(tc.cpr)); !almostEqual(got, tc.cpr) {
+			t.Errorf("CPR round trip = %f, expected = %f", got, tc.cpr)
+		}
+	}
+}
+
+func TestPSACPR(t *testing.T) {
+	testCases := []struct {
+		speed float64
+		age   int
+		cpr   float64
+	}{
+		{100, 1, 0.002},
+		{100, 15, 0.03},
+		{100, 30, 0.06},
+		{100, 200, 0.06},
+		{150, 10, 0.03},
+		{50, 40, 0.03},
+	}
+	for _, tc := range testCases {
+		if got := PSACPR(tc.speed, tc.age); !almostEqual(got, tc.cpr) {
+			t.Errorf("%f PSA CPR in month %d = %f, expected = %f", tc.speed, tc.age, got, tc.cpr)
+		}
+		if got := PSA(tc.speed).Monthly(tc.age); !almostEqual(got, SMM(tc.cpr)) {
+			t.Errorf("%f PSA SMM in month %d = %f, expected = %f", tc.speed, tc.age, got, SMM(tc.cpr))
+		}
+	}
+	if got := CPR(0.06).Monthly(1); !almostEqual(got, SMM(0.06)) {
+		t.Errorf("constant CPR SMM = %f, expected = %f", got, SMM(0.06))
+	}
+	if got := CDR(0.02).Monthly(100); !almostEqual(got, SMM(0.02)) {
+		t.Errorf("constant CDR MDR = %f, expected = %f", got, SMM(0.02))
+	}
+}
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) < tolerance
+}
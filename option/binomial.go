@@ -0,0 +1,93 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package option
+
+import (
+	"fmt"
+	"math"
+)
+
+// Binomial models an option priced using a Cox-Ross-Rubinstein (CRR)
+// binomial tree with the given number of Steps. American options may be
+// exercised early at any node of the tree. The Rate, Dividend, Volatility,
+// and Time are the same as for the BSM model. As with the BSM model, an
+// option with no time to expiration is worth its payoff at the spot price, and
+// an option with no volatility is valued on the deterministic forward price
+// path.
+type Binomial struct {
+	Type       Type
+	American   bool
+	Spot       float64
+	Strike     float64
+	Rate       float64
+	Dividend   float64
+	Volatility float64
+	Time       float64
+	Steps      int
+}
+
+// Price calculates the price of the option by working backward through the
+// tree from the payoffs at expiration.
+//
+// u = e^(σ√Δt), d = 1/u, p = (e^((r-q)Δt) - d) / (u - d)
+func (b Binomial) Price() (float64, error) {
+	if b.Steps < 1 {
+		return math.NaN(), fmt.Errorf("need at least one step")
+	}
+	if b.Time <= 0.0 {
+		return b.payoff(b.Spot), nil
+	}
+	dt := b.Time / float64(b.Steps)
+	if b.Volatility <= 0.0 {
+		return b.deterministic(dt), nil
+	}
+	u := math.Exp(b.Volatility * math.Sqrt(dt))
+	d := 1 / u
+	p := (math.Exp((b.Rate-b.Dividend)*dt) - d) / (u - d)
+	if math.IsNaN(p) || p < 0.0 || p > 1.0 {
+		return math.NaN(), fmt.Errorf("risk-neutral probability %f outside of [0, 1]", p)
+	}
+	discount := math.Exp(-b.Rate * dt)
+
+	// Payoffs at expiration, where node j has j up moves.
+	values := make([]float64, b.Steps+1)
+	for j := range values {
+		values[j] = b.payoff(b.Spot * math.Pow(u, float64(2*j-b.Steps)))
+	}
+	for i := b.Steps - 1; i >= 0; i-- {
+		for j := 0; j <= i; j++ {
+			values[j] = discount * (p*values[j+1] + (1-p)*values[j])
+			if b.American {
+				values[j] = math.Max(values[j], b.payoff(b.Spot*math.Pow(u, float64(2*j-i))))
+			}
+		}
+	}
+	return values[0], nil
+}
+
+// deterministic calculates the price of the option when the spot price grows
+// at the rate less the dividend yield without volatility. A European option is
+// worth its discounted payoff at expiration, while an American option is worth
+// the most valuable discounted payoff at any step.
+func (b Binomial) deterministic(dt float64) float64 {
+	value := 0.0
+	for i := 0; i <= b.Steps; i++ {
+		if !b.American && i < b.Steps {
+			continue
+		}
+		t := float64(i) * dt
+		spot := b.Spot * math.Exp((b.Rate-b.Dividend)*t)
+		value = math.Max(value, math.Exp(-b.Rate*t)*b.payoff(spot))
+	}
+	return value
+}
+
+func (b Binomial) payoff(spot float64) float64 {
+	if b.Type == Put {
+		return math.Max(b.Strike-spot, 0.0)
+	}
+	return math.Max(spot-b.Strike, 0.0)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package option
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBinomialPrice(t *testing.T) {
+	testCases := []struct {
+		name      string
+		option    Binomial
+		expected  float64
+		tolerance float64
+	}{
+		// Hull's five-step American put example.
+		{"american_put_5", Binomial{Put, true, 50, 50, 0.10, 0.0, 0.40, 5.0 / 12, 5}, 4.49, 0.005},
+		{"american_put_500", Binomial{Put, true, 50, 50, 0.10, 0.0, 0.40, 5.0 / 12, 500}, 4.28, 0.01},
+		{"european_call", Binomial{Call, false, 42, 40, 0.10, 0.0, 0.20, 0.5, 1000}, 4.759422, 0.005},
+		{"european_put", Binomial{Put, false, 42, 40, 0.10, 0.0, 0.20, 0.5, 1000}, 0.808599, 0.005},
+		// Without dividends, an American call is never exercised early.
+		{"american_call", Binomial{Call, true, 42, 40, 0.10, 0.0, 0.20, 0.5, 1000}, 4.759422, 0.005},
+	}
+	for _, tc := range testCases {
+		got, err := tc.option.Price()
+		if err != nil {
+			t.Errorf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if math.Abs(got-tc.expected) > tc.tolerance {
+			t.Errorf("%s: price = %f, expected = %f", tc.name, got, tc.expected)
+		}
+	}
+
+	// Early exercise makes the American put worth more than the European put.
+	european := Binomial{Put, false, 50, 50, 0.10, 0.0, 0.40, 5.0 / 12, 200}
+	american := european
+	american.American = true
+	e, _ := european.Price()
+	a, _ := american.Price()
+	if a <= e {
+		t.Errorf("American put = %f, expected more than European put = %f", a, e)
+	}
+}
+
+func TestBinomialErrors(t *testing.T) {
+	testCases := []Binomial{
+		{Put, true, 50, 50, 0.10, 0.0, 0.40, 5.0 / 12, 0},
+		{Call, false, 50, 50, 5.00, 0.0, 0.01, 1.0, 2},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.Price(); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
+
+func TestBinomialDegenerate(t *testing.T) {
+	testCases := []struct {
+		binomial Binomial
+		expected float64
+	}{
+		{Binomial{Put, true, 45, 50, 0.05, 0.0, 0.20, 0.0, 10}, 5.0},
+		{Binomial{Call, false, 55, 50, 0.05, 0.0, 0.20, -1.0, 10}, 5.0},
+		{Binomial{Call, false, 50, 50, 0.05, 0.05, 0.0, 1.0, 10}, 0.0},
+		{Binomial{Call, false, 50, 50, 0.05, 0.0, 0.0, 1.0, 10}, 50 - 50*math.Exp(-0.05)},
+		{Binomial{Put, false, 45, 50, 0.05, 0.0, -0.20, 1.0, 10}, 50*math.Exp(-0.05) - 45},
+		{Binomial{Put, true, 45, 50, 0.05, 0.0, 0.0, 1.0, 10}, 5.0},
+	}
+	for _, tc := range testCases {
+		b := tc.binomial
+		price, err := b.Price()
+		if err != nil {
+			t.Errorf("unexpected error for %+v: %s", b, err)
+			continue
+		}
+		if !almostEqual(price, tc.expected) {
+			t.Errorf("Price = %f, expected = %f", price, tc.expected)
+		}
+		if b.American {
+			continue
+		}
+		bsm := BSM{Type: b.Type, Spot: b.Spot, Strike: b.Strike, Rate: b.Rate,
+			Dividend: b.Dividend, Volatility: b.Volatility, Time: b.Time}
+		if !almostEqual(price, bsm.Price()) {
+			t.Errorf("Price = %f, BSM price = %f", price, bsm.Price())
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package option
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/goalseek"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Type is the type of option.
+type Type int
+
+// Option types.
+const (
+	Call Type = 1
+	Put  Type = 2
+)
+
+func (t Type) String() string {
+	switch t {
+	case Call:
+		return "call"
+	case Put:
+		return "put"
+	}
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// BSM models a European option priced using the Black-Scholes-Merton model.
+// The Rate is the continuously compounded risk-free rate, the Dividend is the
+// continuously compounded dividend yield, the Volatility is the annual
+// volatility of the underlying, and the Time is the time to expiration in
+// years. If the Time or the Volatility is not positive, the option is worth
+// its intrinsic value using the forward prices, which is the payoff at
+// expiration when the Time is not positive.
+type BSM struct {
+	Type       Type
+	Spot       float64
+	Strike     float64
+	Rate       float64
+	Dividend   float64
+	Volatility float64
+	Time       float64
+}
+
+// Greeks contains the sensitivities of the option price. Delta and Gamma are
+// with respect to the spot price, Vega is with respect to the volatility (per
+// 1.00 change, not per 1%), Theta is with respect to the passage of time (per
+// year), and Rho is with respect to the risk-free rate (per 1.00 change).
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+	Rho   float64
+}
+
+// Price calculates the price of the option.
+//
+// c = S e^(-qT) N(d1) - K e^(-rT) N(d2)
+// p = K e^(-rT) N(-d2) - S e^(-qT) N(-d1)
+func (o BSM) Price() float64 {
+	d1, d2 := o.d1d2()
+	spot := o.Spot * math.Exp(-o.Dividend*o.time())
+	strike := o.Strike * math.Exp(-o.Rate*o.time())
+	if o.Type == Put {
+		return strike*cdf(-d2) - spot*cdf(-d1)
+	}
+	return spot*cdf(d1) - strike*cdf(d2)
+}
+
+// Greeks calculates the sensitivities of the option price. If the Time or the
+// Volatility is not positive, the Gamma and Vega are zero and the other
+// Greeks are those of the intrinsic value.
+func (o BSM) Greeks() Greeks {
+	d1, d2 := o.d1d2()
+	t := o.time()
+	qf := math.Exp(-o.Dividend * t)
+	rf := math.Exp(-o.Rate * t)
+	var g Greeks
+	decay := 0.0
+	if !o.degenerate() {
+		sqrtT := math.Sqrt(t)
+		g.Gamma = qf * pdf(d1) / (o.Spot * o.Volatility * sqrtT)
+		g.Vega = o.Spot * qf * pdf(d1) * sqrtT
+		decay = -o.Spot * qf * pdf(d1) * o.Volatility / (2 * sqrtT)
+	}
+	if o.Type == Put {
+		g.Delta = qf * (cdf(d1) - 1)
+		g.Theta = decay - o.Dividend*o.Spot*qf*cdf(-d1) + o.Rate*o.Strike*rf*cdf(-d2)
+		g.Rho = -o.Strike * t * rf * cdf(-d2)
+		return g
+	}
+	g.Delta = qf * cdf(d1)
+	g.Theta = decay + o.Dividend*o.Spot*qf*cdf(d1) - o.Rate*o.Strike*rf*cdf(d2)
+	g.Rho = o.Strike * t * rf * cdf(d2)
+	return g
+}
+
+// ImpliedVolatility calculates the volatility for which the option price
+// equals the given market price. The volatility is found using Newton's
+// method with the vega as the derivative, falling back to the bisection
+// method between 0.0001% and 500% if Newton's method fails to converge.
+func ImpliedVolatility(o BSM, price float64) (float64, error) {
+	f := func(vol float64) float64 {
+		o.Volatility = vol
+		return o.Price()
+	}
+	vega := func(vol float64) float64 {
+		o.Volatility = vol
+		return o.Greeks().Vega
+	}
+	result, err := goalseek.Seek(f, price, goalseek.Options{
+		InitialGuess: 0.2,
+		Derivative:   vega,
+	})
+	if err == nil && result.X > 0.0 {
+		return result.X, nil
+	}
+	result, err = goalseek.Seek(f, price, goalseek.Options{
+		Method: goalseek.Bisection,
+		Lower:  1e-6,
+		Upper:  5.0,
+	})
+	if err != nil {
+		return math.NaN(), fmt.Errorf("cannot find implied volatility: %s", err)
+	}
+	return result.X, nil
+}
+
+// d1d2 calculates d1 and d2. If the option is degenerate, d1 and d2 are
+// +Inf when the option is in the money at the forward prices, -Inf when it
+// is out of the money, and zero when it is at the money, which are the limits
+// as the Time or Volatility approaches zero.
+func (o BSM) d1d2() (float64, float64) {
+	if o.degenerate() {
+		t := o.time()
+		moneyness := o.Spot*math.Exp(-o.Dividend*t) - o.Strike*math.Exp(-o.Rate*t)
+		switch {
+		case moneyness > 0.0:
+			return math.Inf(1), math.Inf(1)
+		case moneyness < 0.0:
+			return math.Inf(-1), math.Inf(-1)
+		}
+		return 0.0, 0.0
+	}
+	volT := o.Volatility * math.Sqrt(o.Time)
+	d1 := (math.Log(o.Spot/o.Strike) + (o.Rate-o.Dividend+o.Volatility*o.Volatility/2)*o.Time) / volT
+	return d1, d1 - volT
+}
+
+// degenerate determines if the option has no time value because the Time or
+// the Volatility is not positive.
+func (o BSM) degenerate() bool {
+	return o.Time <= 0.0 || o.Volatility <= 0.0
+}
+
+// time returns the time to expiration, which is zero after expiration.
+func (o BSM) time() float64 {
+	return math.Max(o.Time, 0.0)
+}
+
+func cdf(x float64) float64 {
+	return distuv.UnitNormal.CDF(x)
+}
+
+func pdf(x float64) float64 {
+	return distuv.UnitNormal.Prob(x)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package option
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBSMPrice(t *testing.T) {
+	// Examples from Hull, Options, Futures, and Other Derivatives.
+	testCases := []struct {
+		option   BSM
+		expected float64
+	}{
+		{BSM{Call, 42, 40, 0.10, 0.0, 0.20, 0.5}, 4.759422},
+		{BSM{Put, 42, 40, 0.10, 0.0, 0.20, 0.5}, 0.808599},
+		{BSM{Call, 930, 900, 0.08, 0.03, 0.20, 2.0 / 12}, 51.832957},
+	}
+	for _, tc := range testCases {
+		if got := tc.option.Price(); math.Abs(got-tc.expected) > 0.0001 {
+			t.Errorf("%s price = %f, expected = %f", tc.option.Type, got, tc.expected)
+		}
+	}
+
+	// Put-call parity: c - p = S e^(-qT) - K e^(-rT)
+	call := BSM{Call, 100, 95, 0.05, 0.02, 0.30, 0.75}
+	put := call
+	put.Type = Put
+	parity := 100*math.Exp(-0.02*0.75) - 95*math.Exp(-0.05*0.75)
+	if diff := call.Price() - put.Price(); math.Abs(diff-parity) > 1e-9 {
+		t.Errorf("put-call parity difference = %f, expected = %f", diff, parity)
+	}
+}
+
+func TestBSMGreeks(t *testing.T) {
+	// Each Greek is checked against a central finite difference of the price.
+	const h = 1e-5
+	for _, o := range []BSM{
+		{Call, 49, 50, 0.05, 0.0, 0.20, 0.3846},
+		{Put, 49, 50, 0.05, 0.02, 0.20, 0.3846},
+	} {
+		g := o.Greeks()
+		bump := func(f func(*BSM, float64)) float64 {
+			up, down := o, o
+			f(&up, h)
+			f(&down, -h)
+			return (up.Price() - down.Price()) / (2 * h)
+		}
+		delta := bump(func(b *BSM, dx float64) { b.Spot += dx })
+		vega := bump(func(b *BSM, dx float64) { b.Volatility += dx })
+		rho := bump(func(b *BSM, dx float64) { b.Rate += dx })
+		theta := -bump(func(b *BSM, dx float64) { b.Time += dx })
+		up, down := o, o
+		up.Spot += 0.01
+		down.Spot -= 0.01
+		gamma := (up.Price() - 2*o.Price() + down.Price()) / (0.01 * 0.01)
+
+		expected := []struct {
+			label string
+			got   float64
+			want  float64
+		}{
+			{"delta", g.Delta, delta},
+			{"gamma", g.Gamma, gamma},
+			{"vega", g.Vega, vega},
+			{"theta", g.Theta, theta},
+			{"rho", g.Rho, rho},
+		}
+		for _, e := range expected {
+			if math.Abs(e.got-e.want) > 0.0001 {
+				t.Errorf("%s %s = %f, expected = %f", o.Type, e.label, e.got, e.want)
+			}
+		}
+	}
+	// Hull's example delta for the call is 0.522.
+	if got := (BSM{Call, 49, 50, 0.05, 0.0, 0.20, 0.3846}).Greeks().Delta; math.Abs(got-0.522) > 0.001 {
+		t.Errorf("call delta = %f, expected = 0.522", got)
+	}
+}
+
+func TestBSMDegenerate(t *testing.T) {
+	// At expiration the theta is the rate of change of the intrinsic value
+	// using the forward price of the strike.
+	testCases := []struct {
+		name   string
+		option BSM
+		price  float64
+		greeks Greeks
+	}{
+		{"expired_call_itm", BSM{Call, 110, 100, 0.05, 0.0, 0.20, 0}, 10, Greeks{Delta: 1, Theta: -5}},
+		{"expired_call_otm", BSM{Call, 90, 100, 0.05, 0.0, 0.20, 0}, 0, Greeks{}},
+		{"expired_call_atm", BSM{Call, 100, 100, 0.05, 0.0, 0.20, 0}, 0, Greeks{Delta: 0.5, Theta: -2.5}},
+		{"expired_put_itm", BSM{Put, 90, 100, 0.05, 0.0, 0.20, 0}, 10, Greeks{Delta: -1, Theta: 5}},
+		{"past_expiration", BSM{Put, 90, 100, 0.05, 0.0, 0.20, -0.5}, 10, Greeks{Delta: -1, Theta: 5}},
+		{
+			"zero_volatility_call", BSM{Call, 100, 100, 0.05, 0.0, 0.0, 1}, 4.877058,
+			Greeks{Delta: 1, Theta: -4.756147, Rho: 95.122942},
+		},
+		{"zero_volatility_put", BSM{Put, 100, 100, 0.05, 0.0, 0.0, 1}, 0, Greeks{}},
+	}
+	for _, tc := range testCases {
+		if got := tc.option.Price(); math.Abs(got-tc.price) > 0.000001 {
+			t.Errorf("%s: price = %f, expected = %f", tc.name, got, tc.price)
+		}
+		g := tc.option.Greeks()
+		expected := []struct {
+			label string
+			got   float64
+			want  float64
+		}{
+			{"delta", g.Delta, tc.greeks.Delta},
+			{"gamma", g.Gamma, tc.greeks.Gamma},
+			{"vega", g.Vega, tc.greeks.Vega},
+			{"theta", g.Theta, tc.greeks.Theta},
+			{"rho", g.Rho, tc.greeks.Rho},
+		}
+		for _, e := range expected {
+			if math.Abs(e.got-e.want) > 0.000001 {
+				t.Errorf("%s: %s = %f, expected = %f", tc.name, e.label, e.got, e.want)
+			}
+		}
+	}
+}
+
+func TestImpliedVolatility(t *testing.T) {
+	testCases := []BSM{
+		{Call, 42, 40, 0.10, 0.0, 0.20, 0.5},
+		{Put, 42, 40, 0.10, 0.0, 0.35, 0.5},
+		{Call, 100, 150, 0.05, 0.0, 0.80, 0.25},
+		{Put, 100, 60, 0.02, 0.01, 1.50, 1.0},
+	}
+	for _, tc := range testCases {
+		vol, err := ImpliedVolatility(tc, tc.Price())
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		if math.Abs(vol-tc.Volatility) > 0.00001 {
+			t.Errorf("implied volatility = %f, expected = %f", vol, tc.Volatility)
+		}
+	}
+	// A call price below its intrinsic value has no implied volatility.
+	if _, err := ImpliedVolatility(BSM{Call, 42, 40, 0.10, 0.0, 0.20, 0.5}, 1.0); err == nil {
+		t.Errorf("expected an error for a price below intrinsic value")
+	}
+}
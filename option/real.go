@@ -0,0 +1,181 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package option
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Kind is the kind of managerial flexibility (i.e., real option) in a
+// project.
+type Kind int
+
+// Real option kinds.
+const (
+	Defer    Kind = 1
+	Expand   Kind = 2
+	Contract Kind = 3
+	Abandon  Kind = 4
+	Switch   Kind = 5
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Defer:
+		return "defer"
+	case Expand:
+		return "expand"
+	case Contract:
+		return "contract"
+	case Abandon:
+		return "abandon"
+	case Switch:
+		return "switch"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// RealOption models a real option that may be exercised at any node of the
+// project value lattice, where V is the project value at the node.
+//
+//   - Defer waits to make the initial investment, so the exercise value is
+//     V - Investment. Defer cannot be combined with other options.
+//   - Expand scales up the project by the Factor at the Cost, so the exercise
+//     value is (1 + Factor) * V - Cost.
+//   - Contract scales down the project by the Factor saving the Value, so the
+//     exercise value is (1 - Factor) * V + Value.
+//   - Abandon sells the project for its salvage Value.
+//   - Switch moves the project to an alternative use worth Factor * V at the
+//     switching Cost, so the exercise value is Factor * V - Cost.
+type RealOption struct {
+	Kind   Kind
+	Factor float64
+	Cost   float64
+	Value  float64
+}
+
+// exercise returns the value of exercising the option at a node with the
+// project value.
+func (o RealOption) exercise(value float64) float64 {
+	switch o.Kind {
+	case Expand:
+		return (1+o.Factor)*value - o.Cost
+	case Contract:
+		return (1-o.Factor)*value + o.Value
+	case Abandon:
+		return o.Value
+	case Switch:
+		return o.Factor*value - o.Cost
+	}
+	return value
+}
+
+// Project models a project for real options valuation. The Cashflows are the
+// expected cash flows, where the negative cash flow in period 0 is the initial
+// investment, and are discounted at the risk-adjusted Rate to find the
+// present value of the project. The project value follows a binomial lattice
+// with the annual Volatility over the Time in years of the options' lives
+// using the given number of Steps, and the RiskFree rate is the continuously
+// compounded annual risk-free rate.
+type Project struct {
+	Cashflows  []float64
+	Rate       float64
+	RiskFree   float64
+	Volatility float64
+	Time       float64
+	Steps      int
+}
+
+// RealOptionsValue contains the results of valuing a project with real
+// options, where the expanded NPV is the static NPV plus the option value.
+type RealOptionsValue struct {
+	PV          float64
+	Investment  float64
+	StaticNPV   float64
+	OptionValue float64
+	ExpandedNPV float64
+}
+
+// Value values the project with the real options using a Cox-Ross-Rubinstein
+// binomial lattice on the project's present value. At each node the project
+// value is the greater of continuing and exercising the most valuable option.
+func (p Project) Value(options ...RealOption) (RealOptionsValue, error) {
+	if len(p.Cashflows) == 0 {
+		return RealOptionsValue{}, fmt.Errorf("need project cash flows")
+	}
+	if p.Steps < 1 {
+		return RealOptionsValue{}, fmt.Errorf("need at least one step")
+	}
+	if p.Volatility <= 0.0 {
+		return RealOptionsValue{}, fmt.Errorf("volatility %f must be positive", p.Volatility)
+	}
+	isDefer := false
+	for _, o := range options {
+		if o.Kind < Defer || o.Kind > Switch {
+			return RealOptionsValue{}, fmt.Errorf("unknown real option %s", o.Kind)
+		}
+		if o.Kind == Defer {
+			isDefer = true
+		}
+	}
+	if isDefer && len(options) > 1 {
+		return RealOptionsValue{}, fmt.Errorf("defer cannot be combined with other options")
+	}
+
+	investment := -p.Cashflows[0]
+	staticNPV := cf.NPV(p.Cashflows, p.Rate)
+	pv := staticNPV + investment
+
+	dt := p.Time / float64(p.Steps)
+	u := math.Exp(p.Volatility * math.Sqrt(dt))
+	d := 1 / u
+	prob := (math.Exp(p.RiskFree*dt) - d) / (u - d)
+	if math.IsNaN(prob) || prob < 0.0 || prob > 1.0 {
+		return RealOptionsValue{}, fmt.Errorf("risk-neutral probability %f outside of [0, 1]", prob)
+	}
+	discount := math.Exp(-p.RiskFree * dt)
+
+	node := func(i, j int, continuation float64) float64 {
+		value := pv * math.Pow(u, float64(2*j-i))
+		if isDefer {
+			return math.Max(continuation, value-investment)
+		}
+		best := continuation
+		for _, o := range options {
+			best = math.Max(best, o.exercise(value))
+		}
+		return best
+	}
+
+	values := make([]float64, p.Steps+1)
+	for j := range values {
+		terminal := pv * math.Pow(u, float64(2*j-p.Steps))
+		if isDefer {
+			terminal = 0.0
+		}
+		values[j] = node(p.Steps, j, terminal)
+	}
+	for i := p.Steps - 1; i >= 0; i-- {
+		for j := 0; j <= i; j++ {
+			values[j] = node(i, j, discount*(prob*values[j+1]+(1-prob)*values[j]))
+		}
+	}
+
+	expandedNPV := values[0] - investment
+	if isDefer {
+		expandedNPV = values[0]
+	}
+	return RealOptionsValue{
+		PV:          pv,
+		Investment:  investment,
+		StaticNPV:   staticNPV,
+		OptionValue: expandedNPV - staticNPV,
+		ExpandedNPV: expandedNPV,
+	}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package option
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProjectValue(t *testing.T) {
+	// The PV of the project's future cash flows is 100.
+	project := Project{
+		Cashflows:  []float64{-105, 110},
+		Rate:       0.10,
+		RiskFree:   0.05,
+		Volatility: 0.30,
+		Time:       3,
+		Steps:      300,
+	}
+	price := func(b Binomial) float64 {
+		b.Spot, b.Rate, b.Volatility, b.Time, b.Steps = 100, 0.05, 0.30, 3, 300
+		p, err := b.Price()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	testCases := []struct {
+		name     string
+		option   RealOption
+		expected float64
+	}{
+		// Each option is equivalent to an American option on the project value.
+		{"defer", RealOption{Kind: Defer}, price(Binomial{Type: Call, American: true, Strike: 105}) + 5},
+		{"expand", RealOption{Kind: Expand, Factor: 0.5, Cost: 40}, 0.5 * price(Binomial{Type: Call, American: true, Strike: 80})},
+		{"contract", RealOption{Kind: Contract, Factor: 0.25, Value: 20}, 0.25 * price(Binomial{Type: Put, American: true, Strike: 80})},
+		{"abandon", RealOption{Kind: Abandon, Value: 70}, price(Binomial{Type: Put, American: true, Strike: 70})},
+		{"switch", RealOption{Kind: Switch, Factor: 1.2, Cost: 30}, 0.2 * price(Binomial{Type: Call, American: true, Strike: 150})},
+	}
+	for _, tc := range testCases {
+		got, err := project.Value(tc.option)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if !almostEqual(got.PV, 100) {
+			t.Errorf("%s: PV = %f, expected = %f", tc.name, got.PV, 100.0)
+		}
+		if !almostEqual(got.StaticNPV, -5) {
+			t.Errorf("%s: static NPV = %f, expected = %f", tc.name, got.StaticNPV, -5.0)
+		}
+		if !almostEqual(got.OptionValue, tc.expected) {
+			t.Errorf("%s: option value = %f, expected = %f", tc.name, got.OptionValue, tc.expected)
+		}
+		if !almostEqual(got.ExpandedNPV, got.StaticNPV+got.OptionValue) {
+			t.Errorf("%s: expanded NPV = %f, expected = %f", tc.name, got.ExpandedNPV, got.StaticNPV+got.OptionValue)
+		}
+	}
+
+	// Without flexibility the expanded NPV is the static NPV.
+	got, err := project.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(got.OptionValue, 0) {
+		t.Errorf("no options: option value = %f, expected = %f", got.OptionValue, 0.0)
+	}
+
+	// Combined options are worth at least as much as either option alone.
+	abandon := RealOption{Kind: Abandon, Value: 70}
+	expand := RealOption{Kind: Expand, Factor: 0.5, Cost: 40}
+	both, _ := project.Value(abandon, expand)
+	a, _ := project.Value(abandon)
+	e, _ := project.Value(expand)
+	if both.OptionValue < math.Max(a.OptionValue, e.OptionValue) {
+		t.Errorf("combined option value = %f, expected at least %f", both.OptionValue, math.Max(a.OptionValue, e.OptionValue))
+	}
+}
+
+func TestProjectValueErrors(t *testing.T) {
+	project := Project{[]float64{-105, 110}, 0.10, 0.05, 0.30, 3, 300}
+	testCases := []struct {
+		name    string
+		project Project
+		options []RealOption
+	}{
+		{"no_cashflows", Project{Steps: 10}, nil},
+		{"no_steps", Project{Cashflows: []float64{-105, 110}}, nil},
+		{"no_volatility", Project{[]float64{-105, 110}, 0.10, 0.05, 0.0, 3, 300}, nil},
+		{"no_time", Project{[]float64{-105, 110}, 0.10, 0.05, 0.30, 0, 300}, nil},
+		{"unknown_kind", project, []RealOption{{Kind: 9}}},
+		{"defer_combined", project, []RealOption{{Kind: Defer}, {Kind: Abandon, Value: 70}}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.project.Value(tc.options...); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+}
+
+const tolerance = 0.000001
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) <= tolerance
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package perf
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/goinvest/fin/cf"
+	"github.com/goinvest/fin/daycount"
+)
+
+// Valuation models the market value of a portfolio on a date along with the
+// external cash flow on that date. The value is measured immediately before
+// the flow, and contributions are positive while withdrawals are negative.
+type Valuation struct {
+	Date  time.Time
+	Value float64
+	Flow  float64
+}
+
+// Period models a measurement period with the portfolio value at the start and
+// end of the period and the external cash flows during the period, where
+// contributions are positive and withdrawals are negative.
+type Period struct {
+	Start      time.Time
+	End        time.Time
+	BeginValue float64
+	EndValue   float64
+	Flows      []cf.DatedCashflow
+}
+
+// TimeWeightedReturn calculates the True Time-Weighted Return (TWR) from the
+// portfolio valuations taken on the date of each external cash flow. The
+// return for each sub-period is geometrically linked, which removes the effect
+// of the timing and size of the external cash flows.
+//
+// TWR = ∏(V_i / (V_i-1 + F_i-1)) - 1
+func TimeWeightedReturn(valuations []Valuation) (float64, error) {
+	if len(valuations) < 2 {
+		return math.NaN(), fmt.Errorf("need at least two valuations")
+	}
+	growth := 1.0
+	for i := 1; i < len(valuations); i++ {
+		begin := valuations[i-1].Value + valuations[i-1].Flow
+		if begin == 0.0 {
+			return math.NaN(), fmt.Errorf("zero value at start of sub-period %d", i)
+		}
+		growth *= valuations[i].Value / begin
+	}
+	return growth - 1, nil
+}
+
+// ModifiedDietz calculates the Modified Dietz return for the period, which
+// approximates the time-weighted return by weighting each external cash flow
+// by the fraction of the period it was invested.
+//
+// R = (EMV - BMV - ∑F_i) / (BMV + ∑(W_i * F_i)), where W_i = (CD - D_i) / CD
+func ModifiedDietz(p Period) (float64, error) {
+	totalDays := float64(daycount.Days(p.Start, p.End))
+	if totalDays <= 0 {
+		return math.NaN(), fmt.Errorf("period end must be after start")
+	}
+	netFlows, weightedFlows := 0.0, 0.0
+	for _, flow := range p.Flows {
+		if flow.Date.Before(p.Start) || flow.Date.After(p.End) {
+			return math.NaN(), fmt.Errorf("flow on %s is outside of the period", flow.Date.Format("2006-01-02"))
+		}
+		weight := (totalDays - float64(daycount.Days(p.Start, flow.Date))) / totalDays
+		netFlows += flow.Amount
+		weightedFlows += weight * flow.Amount
+	}
+	denominator := p.BeginValue + weightedFlows
+	if denominator == 0.0 {
+		return math.NaN(), fmt.Errorf("average capital is zero")
+	}
+	return (p.EndValue - p.BeginValue - netFlows) / denominator, nil
+}
+
+// LinkedModifiedDietz calculates the return over consecutive periods by
+// geometrically linking the Modified Dietz return of each period.
+func LinkedModifiedDietz(periods []Period) (float64, error) {
+	growth := 1.0
+	for _, p := range periods {
+		r, err := ModifiedDietz(p)
+		if err != nil {
+			return math.NaN(), err
+		}
+		growth *= 1 + r
+	}
+	return growth - 1, nil
+}
+
+// MoneyWeightedReturn calculates the Money-Weighted Return (MWR), which is the
+// internal rate of return of the beginning value, the external cash flows, and
+// the ending value of the period calculated using cf.XIRR. Following the
+// annualization rule, the MWR is an annual rate for periods of at least one
+// year and the actual return for the period otherwise.
+func MoneyWeightedReturn(p Period) (float64, error) {
+	cashflows := make([]cf.DatedCashflow, 0, len(p.Flows)+2)
+	cashflows = append(cashflows, cf.DatedCashflow{Date: p.Start, Amount: -p.BeginValue})
+	for _, flow := range p.Flows {
+		cashflows = append(cashflows, cf.DatedCashflow{Date: flow.Date, Amount: -flow.Amount})
+	}
+	cashflows = append(cashflows, cf.DatedCashflow{Date: p.End, Amount: p.EndValue})
+	annual, err := cf.XIRR(cashflows)
+	if err != nil {
+		return math.NaN(), err
+	}
+	years := Years(p.Start, p.End)
+	if years < 1.0 {
+		return math.Pow(1+annual, years) - 1, nil
+	}
+	return annual, nil
+}
+
+// Annualize converts a cumulative return over the given number of years into
+// an annual return. Returns for periods of less than one year are not
+// annualized, since doing so would extrapolate the return for the partial year
+// over a full year.
+func Annualize(ret, years float64) float64 {
+	if years < 1.0 {
+		return ret
+	}
+	return math.Pow(1+ret, 1/years) - 1
+}
+
+// Years calculates the number of years between the start and end dates using
+// the ACT/365 Fixed day count.
+func Years(start, end time.Time) float64 {
+	return daycount.Actual365Fixed{}.YearFraction(start, end)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package perf
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/goinvest/fin/cf"
+)
+
+const tolerance = 0.000001
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var testPeriod = Period{
+	Start:      date(2024, 1, 1),
+	End:        date(2024, 1, 31),
+	BeginValue: 100,
+	EndValue:   120,
+	Flows:      []cf.DatedCashflow{{Date: date(2024, 1, 16), Amount: 10}},
+}
+
+func TestTimeWeightedReturn(t *testing.T) {
+	testCases := []struct {
+		valuations []Valuation
+		expected   float64
+	}{
+		{
+			[]Valuation{
+				{date(2024, 1, 1), 100, 0},
+				{date(2024, 1, 16), 105, 10},
+				{date(2024, 1, 31), 120, 0},
+			},
+			0.095652,
+		},
+		{
+			[]Valuation{
+				{date(2024, 1, 1), 100, 0},
+				{date(2024, 6, 30), 50, 1000},
+				{date(2024, 12, 31), 1050, 0},
+			},
+			-0.5,
+		},
+	}
+	for _, tc := range testCases {
+		got, err := TimeWeightedReturn(tc.valuations)
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("TWR = %f, expected = %f", got, tc.expected)
+		}
+	}
+	if _, err := TimeWeightedReturn([]Valuation{{Value: 100}}); err == nil {
+		t.Errorf("expected an error for a single valuation")
+	}
+	if _, err := TimeWeightedReturn([]Valuation{{Value: 0}, {Value: 100}}); err == nil {
+		t.Errorf("expected an error for a zero starting value")
+	}
+}
+
+func TestModifiedDietz(t *testing.T) {
+	got, err := ModifiedDietz(testPeriod)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if !almostEqual(got, 0.095238) {
+		t.Errorf("Modified Dietz = %f, expected = %f", got, 0.095238)
+	}
+
+	bad := testPeriod
+	bad.Flows = []cf.DatedCashflow{{Date: date(2024, 2, 15), Amount: 10}}
+	if _, err := ModifiedDietz(bad); err == nil {
+		t.Errorf("expected an error for a flow outside of the period")
+	}
+	bad = testPeriod
+	bad.End = bad.Start
+	if _, err := ModifiedDietz(bad); err == nil {
+		t.Errorf("expected an error for an empty period")
+	}
+}
+
+func TestLinkedModifiedDietz(t *testing.T) {
+	periods := []Period{
+		{Start: date(2024, 1, 1), End: date(2024, 3, 31), BeginValue: 100, EndValue: 110},
+		{Start: date(2024, 3, 31), End: date(2024, 6, 30), BeginValue: 110, EndValue: 115.5},
+	}
+	got, err := LinkedModifiedDietz(periods)
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if !almostEqual(got, 0.155) {
+		t.Errorf("linked Modified Dietz = %f, expected = %f", got, 0.155)
+	}
+}
+
+func TestMoneyWeightedReturn(t *testing.T) {
+	testCases := []struct {
+		period   Period
+		expected float64
+	}{
+		{testPeriod, 0.095341},
+		{
+			Period{
+				Start:      date(2024, 1, 1),
+				End:        date(2026, 1, 1),
+				BeginValue: 100,
+				EndValue:   120,
+				Flows:      []cf.DatedCashflow{{Date: date(2024, 7, 19), Amount: 10}},
+			},
+			0.045549,
+		},
+	}
+	for _, tc := range testCases {
+		got, err := MoneyWeightedReturn(tc.period)
+		if err != nil {
+			t.Errorf("expected no error, got: %s", err)
+		}
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("MWR = %f, expected = %f", got, tc.expected)
+		}
+	}
+}
+
+func TestAnnualize(t *testing.T) {
+	testCases := []struct {
+		ret      float64
+		years    float64
+		expected float64
+	}{
+		{0.21, 2.0, 0.10},
+		{0.05, 0.5, 0.05},
+		{0.10, 1.0, 0.10},
+	}
+	for _, tc := range testCases {
+		if got := Annualize(tc.ret, tc.years); !almostEqual(got, tc.expected) {
+			t.Errorf("annualized %f over %f years = %f, expected = %f", tc.ret, tc.years, got, tc.expected)
+		}
+	}
+}
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) < tolerance
+}
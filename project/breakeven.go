@@ -0,0 +1,106 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"math"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Breakeven models the information needed to determine the number of units a
+// project must sell each period to break even. The Investment is made in
+// period 0 and is depreciated over the Life of the project. If the Investment
+// is zero, straight-line depreciation with no salvage value is assumed, so the
+// Investment is the Depreciation times the Life.
+type Breakeven struct {
+	Price        float64 // Sales price per unit
+	VariableCost float64 // Variable cost per unit
+	FixedCosts   float64 // Fixed costs per period excluding depreciation
+	Depreciation float64 // Depreciation per period
+	TaxRate      float64
+	Life         int     // Number of periods
+	DiscountRate float64 // Discount rate per period
+	Investment   float64 // Initial investment in period 0
+}
+
+// Accounting calculates the accounting breakeven volume, which is the number
+// of units per period for which the net income is zero.
+//
+// Q = (FC + D) / (P - v)
+func (b Breakeven) Accounting() float64 {
+	return (b.FixedCosts + b.Depreciation) / b.contributionMargin()
+}
+
+// Cash calculates the cash breakeven volume, which is the number of units per
+// period for which the operating cash flow is zero, ignoring taxes.
+//
+// Q = FC / (P - v)
+func (b Breakeven) Cash() float64 {
+	return b.FixedCosts / b.contributionMargin()
+}
+
+// Financial calculates the financial breakeven volume, which is the number of
+// units per period for which the Net Present Value (NPV) of the project is
+// zero at the discount rate. Since the NPV is linear in the number of units
+// sold, the breakeven volume is found from the NPV at zero units and the
+// change in NPV per unit. NaN is returned if the Life is less than one period.
+func (b Breakeven) Financial() float64 {
+	if b.Life < 1 {
+		return math.NaN()
+	}
+	npv0 := cf.NPV(b.Cashflows(0.0), b.DiscountRate)
+	npv1 := cf.NPV(b.Cashflows(1.0), b.DiscountRate)
+	if npv1 == npv0 {
+		return math.NaN()
+	}
+	return -npv0 / (npv1 - npv0)
+}
+
+// OCF calculates the after-tax operating cash flow per period when selling the
+// given number of units per period.
+//
+// OCF = [(P - v) * Q - FC - D] * (1 - t) + D
+func (b Breakeven) OCF(units float64) float64 {
+	ebit := b.contributionMargin()*units - b.FixedCosts - b.Depreciation
+	return ebit*(1-b.TaxRate) + b.Depreciation
+}
+
+// Cashflows returns the project cashflows for periods 0 through the life of
+// the project when selling the given number of units per period. No cashflows
+// are returned if the Life is less than one period.
+func (b Breakeven) Cashflows(units float64) []float64 {
+	if b.Life < 1 {
+		return []float64{}
+	}
+	cashflows := make([]float64, b.Life+1)
+	cashflows[0] = -b.investment()
+	ocf := b.OCF(units)
+	for i := 1; i <= b.Life; i++ {
+		cashflows[i] = ocf
+	}
+	return cashflows
+}
+
+// DOL calculates the Degree of Operating Leverage (DOL) when selling the
+// given number of units per period, which is the percentage change in
+// operating cash flow for a percentage change in units sold.
+//
+// DOL = 1 + FC / OCF, where OCF = (P - v) * Q - FC
+func (b Breakeven) DOL(units float64) float64 {
+	return 1 + b.FixedCosts/(b.contributionMargin()*units-b.FixedCosts)
+}
+
+func (b Breakeven) contributionMargin() float64 {
+	return b.Price - b.VariableCost
+}
+
+func (b Breakeven) investment() float64 {
+	if b.Investment != 0.0 {
+		return b.Investment
+	}
+	return b.Depreciation * float64(b.Life)
+}
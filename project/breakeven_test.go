@@ -0,0 +1,85 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goinvest/fin/cf"
+)
+
+const tolerance = 0.0001
+
+func TestBreakeven(t *testing.T) {
+	testCases := []struct {
+		breakeven  Breakeven
+		accounting float64
+		cash       float64
+		financial  float64
+	}{
+		{
+			Breakeven{Price: 40, VariableCost: 20, FixedCosts: 500000, Depreciation: 700000, Life: 5, DiscountRate: 0.20},
+			60000, 25000, 83516.448076,
+		},
+		{
+			Breakeven{Price: 40, VariableCost: 20, FixedCosts: 500000, Depreciation: 700000, TaxRate: 0.30, Life: 5, DiscountRate: 0.20, Investment: 3500000},
+			60000, 25000, 93594.925822,
+		},
+	}
+	for _, tc := range testCases {
+		if got := tc.breakeven.Accounting(); !almostEqual(got, tc.accounting) {
+			t.Errorf("accounting breakeven = %f, expected = %f", got, tc.accounting)
+		}
+		if got := tc.breakeven.Cash(); !almostEqual(got, tc.cash) {
+			t.Errorf("cash breakeven = %f, expected = %f", got, tc.cash)
+		}
+		got := tc.breakeven.Financial()
+		if !almostEqual(got, tc.financial) {
+			t.Errorf("financial breakeven = %f, expected = %f", got, tc.financial)
+		}
+		if npv := cf.NPV(tc.breakeven.Cashflows(got), tc.breakeven.DiscountRate); !almostEqual(npv, 0.0) {
+			t.Errorf("NPV at financial breakeven = %f, expected = 0", npv)
+		}
+		// At the accounting breakeven the operating cash flow equals the
+		// depreciation.
+		if ocf := tc.breakeven.OCF(tc.accounting); !almostEqual(ocf, tc.breakeven.Depreciation) {
+			t.Errorf("OCF at accounting breakeven = %f, expected = %f", ocf, tc.breakeven.Depreciation)
+		}
+	}
+}
+
+func TestBreakevenNoLife(t *testing.T) {
+	for _, life := range []int{0, -1, -5} {
+		b := Breakeven{Price: 40, VariableCost: 20, FixedCosts: 500000, Depreciation: 700000, Life: life, DiscountRate: 0.20}
+		if got := b.Financial(); !math.IsNaN(got) {
+			t.Errorf("life %d: financial breakeven = %f, expected NaN", life, got)
+		}
+		if got := b.Cashflows(1000); len(got) != 0 {
+			t.Errorf("life %d: cashflows = %v, expected none", life, got)
+		}
+	}
+}
+
+func TestDOL(t *testing.T) {
+	testCases := []struct {
+		breakeven Breakeven
+		units     float64
+		expected  float64
+	}{
+		{Breakeven{Price: 40, VariableCost: 20, FixedCosts: 500000}, 40000, 2.666667},
+		{Breakeven{Price: 10, VariableCost: 5, FixedCosts: 0}, 1000, 1.0},
+	}
+	for _, tc := range testCases {
+		if got := tc.breakeven.DOL(tc.units); !almostEqual(got, tc.expected) {
+			t.Errorf("DOL = %f, expected = %f", got, tc.expected)
+		}
+	}
+}
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) < tolerance
+}
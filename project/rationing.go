@@ -0,0 +1,230 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"fmt"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Candidate models a project competing for a limited capital budget. The NPV
+// is calculated from the Cashflows. The Outlays are the capital required in
+// each period; if nil, the outlays are the negative cashflows.
+type Candidate struct {
+	Name      string
+	Cashflows []float64
+	Outlays   []float64
+}
+
+// outlays returns the capital required in each period.
+func (c Candidate) outlays() []float64 {
+	if c.Outlays != nil {
+		return c.Outlays
+	}
+	outlays := make([]float64, len(c.Cashflows))
+	for i, cashflow := range c.Cashflows {
+		if cashflow < 0.0 {
+			outlays[i] = -cashflow
+		}
+	}
+	return outlays
+}
+
+// Dependency requires that the Project may only be selected if the Requires
+// project is also selected.
+type Dependency struct {
+	Project  string
+	Requires string
+}
+
+// Rationing models the capital rationing problem of selecting the subset of
+// candidates that maximizes the total NPV at the DiscountRate. The Budgets are
+// the capital available in each period; outlays in periods beyond the
+// budgets are unconstrained. At most one project from each group of
+// Exclusive projects may be selected, and every Dependency must be satisfied.
+type Rationing struct {
+	Candidates   []Candidate
+	DiscountRate float64
+	Budgets      []float64
+	Exclusive    [][]string
+	Dependencies []Dependency
+}
+
+// Selection contains the NPV-maximizing portfolio of projects, the NPV of
+// each candidate, and the total outlays of the portfolio in each budgeted
+// period.
+type Selection struct {
+	Projects []string
+	NPV      float64
+	NPVs     map[string]float64
+	Outlays  []float64
+}
+
+// Select finds the NPV-maximizing portfolio using an exact branch-and-bound
+// search over the include/exclude decision for each candidate. The search is
+// exponential in the worst case, so it is intended for moderate numbers of
+// candidates.
+func (r Rationing) Select() (Selection, error) {
+	n := len(r.Candidates)
+	index := make(map[string]int, n)
+	npvs := make([]float64, n)
+	outlays := make([][]float64, n)
+	sel := Selection{NPVs: make(map[string]float64, n)}
+	for i, c := range r.Candidates {
+		if _, ok := index[c.Name]; ok {
+			return Selection{}, fmt.Errorf("duplicate project %s", c.Name)
+		}
+		index[c.Name] = i
+		npvs[i] = cf.NPV(c.Cashflows, r.DiscountRate)
+		sel.NPVs[c.Name] = npvs[i]
+		outlays[i] = c.outlays()
+		for _, outlay := range outlays[i] {
+			if outlay < 0.0 {
+				return Selection{}, fmt.Errorf("negative outlay for project %s", c.Name)
+			}
+		}
+	}
+	for _, b := range r.Budgets {
+		if b < 0.0 {
+			return Selection{}, fmt.Errorf("negative budget %f", b)
+		}
+	}
+
+	// exclusive[i] lists the projects that cannot be selected with project i.
+	exclusive := make([][]int, n)
+	for _, group := range r.Exclusive {
+		members := make([]int, len(group))
+		for j, name := range group {
+			i, ok := index[name]
+			if !ok {
+				return Selection{}, fmt.Errorf("unknown project %s in exclusive group", name)
+			}
+			members[j] = i
+		}
+		for _, i := range members {
+			for _, j := range members {
+				if i != j {
+					exclusive[i] = append(exclusive[i], j)
+				}
+			}
+		}
+	}
+	// requires[i] lists the projects that project i depends on, and
+	// requiredBy[i] lists the projects that depend on project i.
+	requires := make([][]int, n)
+	requiredBy := make([][]int, n)
+	for _, d := range r.Dependencies {
+		i, ok := index[d.Project]
+		if !ok {
+			return Selection{}, fmt.Errorf("unknown project %s in dependency", d.Project)
+		}
+		j, ok := index[d.Requires]
+		if !ok {
+			return Selection{}, fmt.Errorf("unknown project %s in dependency", d.Requires)
+		}
+		requires[i] = append(requires[i], j)
+		requiredBy[j] = append(requiredBy[j], i)
+	}
+
+	// remaining[i] is the sum of the positive NPVs of candidates i through n-1,
+	// which bounds the NPV that can still be added at depth i.
+	remaining := make([]float64, n+1)
+	for i := n - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1]
+		if npvs[i] > 0.0 {
+			remaining[i] += npvs[i]
+		}
+	}
+
+	const (
+		undecided = 0
+		included  = 1
+		excluded  = -1
+	)
+	state := make([]int, n)
+	spent := make([]float64, len(r.Budgets))
+	best := make([]int, 0, n)
+	bestNPV := 0.0
+	found := false
+
+	feasible := func(i int) bool {
+		for t, outlay := range outlays[i] {
+			if t < len(spent) && spent[t]+outlay > r.Budgets[t]+1e-9 {
+				return false
+			}
+		}
+		for _, j := range exclusive[i] {
+			if state[j] == included {
+				return false
+			}
+		}
+		for _, j := range requires[i] {
+			if state[j] == excluded {
+				return false
+			}
+		}
+		return true
+	}
+	canExclude := func(i int) bool {
+		for _, j := range requiredBy[i] {
+			if state[j] == included {
+				return false
+			}
+		}
+		return true
+	}
+	spend := func(i int, sign float64) {
+		for t, outlay := range outlays[i] {
+			if t < len(spent) {
+				spent[t] += sign * outlay
+			}
+		}
+	}
+
+	var search func(i int, npv float64)
+	search = func(i int, npv float64) {
+		if found && npv+remaining[i] <= bestNPV {
+			return
+		}
+		if i == n {
+			best = best[:0]
+			for j, s := range state {
+				if s == included {
+					best = append(best, j)
+				}
+			}
+			bestNPV = npv
+			found = true
+			return
+		}
+		if feasible(i) {
+			state[i] = included
+			spend(i, 1)
+			search(i+1, npv+npvs[i])
+			spend(i, -1)
+		}
+		if canExclude(i) {
+			state[i] = excluded
+			search(i+1, npv)
+		}
+		state[i] = undecided
+	}
+	search(0, 0.0)
+
+	sel.Projects = make([]string, len(best))
+	sel.Outlays = make([]float64, len(r.Budgets))
+	for k, i := range best {
+		sel.Projects[k] = r.Candidates[i].Name
+		sel.NPV += npvs[i]
+		for t, outlay := range outlays[i] {
+			if t < len(sel.Outlays) {
+				sel.Outlays[t] += outlay
+			}
+		}
+	}
+	return sel, nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/goinvest/fin/cf"
+)
+
+func TestRationingSelect(t *testing.T) {
+	// At a 10% discount rate the NPVs are 20, 20, 19, and 30.
+	candidates := []Candidate{
+		{Name: "A", Cashflows: []float64{-50, 77}},
+		{Name: "B", Cashflows: []float64{-40, 66}},
+		{Name: "C", Cashflows: []float64{-30, 53.9}},
+		{Name: "D", Cashflows: []float64{-60, 99}},
+	}
+	testCases := []struct {
+		name      string
+		rationing Rationing
+		projects  []string
+		npv       float64
+		outlays   []float64
+	}{
+		{
+			"single_budget",
+			Rationing{Candidates: candidates, DiscountRate: 0.10, Budgets: []float64{100}},
+			[]string{"B", "D"}, 50, []float64{100},
+		},
+		{
+			"exclusive",
+			Rationing{Candidates: candidates, DiscountRate: 0.10, Budgets: []float64{100},
+				Exclusive: [][]string{{"B", "D"}}},
+			[]string{"C", "D"}, 49, []float64{90},
+		},
+		{
+			"dependency",
+			Rationing{Candidates: candidates, DiscountRate: 0.10, Budgets: []float64{100},
+				Dependencies: []Dependency{{"D", "A"}}},
+			[]string{"A", "B"}, 40, []float64{90},
+		},
+		{
+			"unconstrained",
+			Rationing{Candidates: candidates, DiscountRate: 0.10},
+			[]string{"A", "B", "C", "D"}, 89, []float64{},
+		},
+		{
+			"multi_period",
+			Rationing{
+				Candidates: []Candidate{
+					{Name: "A", Cashflows: []float64{-10, 30, 5}, Outlays: []float64{10, 0}},
+					{Name: "B", Cashflows: []float64{-5, 5, 20}, Outlays: []float64{5, 5}},
+					{Name: "C", Cashflows: []float64{-5, 5, 15}, Outlays: []float64{5, 5}},
+					{Name: "D", Cashflows: []float64{0, -40, 60}, Outlays: []float64{0, 40}},
+				},
+				DiscountRate: 0.10,
+				Budgets:      []float64{10, 40},
+			},
+			[]string{"A", "D"}, 34.628099, []float64{10, 40},
+		},
+		{
+			// A negative-NPV project is selected when a more valuable
+			// project depends on it.
+			"negative_npv_dependency",
+			Rationing{
+				Candidates: []Candidate{
+					{Name: "Plant", Cashflows: []float64{-100, 88}},
+					{Name: "Line", Cashflows: []float64{-50, 110}},
+				},
+				DiscountRate: 0.10,
+				Budgets:      []float64{200},
+				Dependencies: []Dependency{{"Line", "Plant"}},
+			},
+			[]string{"Plant", "Line"}, 30, []float64{150},
+		},
+	}
+	for _, tc := range testCases {
+		got, err := tc.rationing.Select()
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if !reflect.DeepEqual(got.Projects, tc.projects) {
+			t.Errorf("%s: projects = %v, expected = %v", tc.name, got.Projects, tc.projects)
+		}
+		if !almostEqual(got.NPV, tc.npv) {
+			t.Errorf("%s: NPV = %f, expected = %f", tc.name, got.NPV, tc.npv)
+		}
+		if len(got.Outlays) != len(tc.outlays) {
+			t.Fatalf("%s: outlays = %v, expected = %v", tc.name, got.Outlays, tc.outlays)
+		}
+		for i := range got.Outlays {
+			if !almostEqual(got.Outlays[i], tc.outlays[i]) {
+				t.Errorf("%s: outlays[%d] = %f, expected = %f", tc.name, i, got.Outlays[i], tc.outlays[i])
+			}
+		}
+	}
+}
+
+func TestRationingSelectMatchesEnumeration(t *testing.T) {
+	var candidates []Candidate
+	for i := 0; i < 12; i++ {
+		outlay := float64(10 + (i*37)%50)
+		inflow := outlay * (0.8 + float64((i*53)%60)/100)
+		candidates = append(candidates, Candidate{
+			Name:      fmt.Sprintf("P%d", i),
+			Cashflows: []float64{-outlay, inflow / 2, inflow / 2},
+			Outlays:   []float64{outlay, float64((i * 17) % 30)},
+		})
+	}
+	r := Rationing{
+		Candidates:   candidates,
+		DiscountRate: 0.08,
+		Budgets:      []float64{150, 60},
+		Exclusive:    [][]string{{"P1", "P2", "P3"}},
+		Dependencies: []Dependency{{"P5", "P0"}, {"P7", "P6"}},
+	}
+	got, err := r.Select()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index := map[string]int{}
+	for i, c := range candidates {
+		index[c.Name] = i
+	}
+	best := 0.0
+	for mask := 0; mask < 1<<len(candidates); mask++ {
+		in := func(name string) bool { return mask&(1<<index[name]) != 0 }
+		if in("P5") && !in("P0") || in("P7") && !in("P6") {
+			continue
+		}
+		if n := btoi(in("P1")) + btoi(in("P2")) + btoi(in("P3")); n > 1 {
+			continue
+		}
+		npv, spent := 0.0, []float64{0, 0}
+		for i, c := range candidates {
+			if mask&(1<<i) != 0 {
+				npv += cf.NPV(c.Cashflows, r.DiscountRate)
+				spent[0] += c.Outlays[0]
+				spent[1] += c.Outlays[1]
+			}
+		}
+		if spent[0] <= r.Budgets[0] && spent[1] <= r.Budgets[1] && npv > best {
+			best = npv
+		}
+	}
+	if !almostEqual(got.NPV, best) {
+		t.Errorf("branch-and-bound NPV = %f, expected = %f", got.NPV, best)
+	}
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestRationingSelectErrors(t *testing.T) {
+	a := Candidate{Name: "A", Cashflows: []float64{-10, 20}}
+	testCases := []struct {
+		name      string
+		rationing Rationing
+	}{
+		{"duplicate", Rationing{Candidates: []Candidate{a, a}}},
+		{"negative_outlay", Rationing{Candidates: []Candidate{{Name: "A", Outlays: []float64{-1}}}}},
+		{"negative_budget", Rationing{Candidates: []Candidate{a}, Budgets: []float64{-1}}},
+		{"unknown_exclusive", Rationing{Candidates: []Candidate{a}, Exclusive: [][]string{{"A", "B"}}}},
+		{"unknown_dependency", Rationing{Candidates: []Candidate{a}, Dependencies: []Dependency{{"A", "B"}}}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.rationing.Select(); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+}
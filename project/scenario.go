@@ -0,0 +1,147 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Scenario models a named set of input overrides applied to the base inputs
+// of a model (e.g., best, base, and worst case) with an optional probability.
+type Scenario struct {
+	Name        string
+	Probability float64
+	Overrides   Inputs
+}
+
+// Scenarios models the scenarios to evaluate for a single model with the base
+// inputs. The DiscountRate is used for the NPV, as the finance and reinvestment
+// rate for the MIRR, and for the discounted payback period.
+type Scenarios struct {
+	Model        Model
+	Base         Inputs
+	DiscountRate float64
+	Scenarios    []Scenario
+}
+
+// Outcome contains the cashflows and capital budgeting metrics for a single
+// scenario. The IRR is NaN if it cannot be calculated.
+type Outcome struct {
+	Name              string
+	Probability       float64
+	Cashflows         []float64
+	NPV               float64
+	IRR               float64
+	MIRR              float64
+	Payback           float64
+	DiscountedPayback float64
+}
+
+// Comparison contains the outcome of each scenario. When the scenarios are
+// weighted by probability, the comparison also contains the expected NPV, the
+// standard deviation of the NPV, and the coefficient of variation; otherwise,
+// those are NaN.
+type Comparison struct {
+	Outcomes    []Outcome
+	ExpectedNPV float64
+	StdDev      float64
+	CV          float64
+}
+
+// Evaluate evaluates the cashflows of each scenario through the NPV, IRR,
+// MIRR, and payback periods. If any scenario has a probability, the
+// probabilities must sum to one and are used to weight the NPVs.
+func (s Scenarios) Evaluate() (Comparison, error) {
+	totalProb := 0.0
+	for _, scenario := range s.Scenarios {
+		if scenario.Probability < 0.0 {
+			return Comparison{}, fmt.Errorf("negative probability for scenario %s", scenario.Name)
+		}
+		for name := range scenario.Overrides {
+			if _, ok := s.Base[name]; !ok {
+				return Comparison{}, fmt.Errorf("unknown input %s in scenario %s", name, scenario.Name)
+			}
+		}
+		totalProb += scenario.Probability
+	}
+	weighted := totalProb != 0.0
+	if weighted && math.Abs(totalProb-1.0) > 1e-9 {
+		return Comparison{}, fmt.Errorf("scenario probabilities sum to %f instead of 1", totalProb)
+	}
+
+	c := Comparison{
+		Outcomes:    make([]Outcome, len(s.Scenarios)),
+		ExpectedNPV: math.NaN(),
+		StdDev:      math.NaN(),
+		CV:          math.NaN(),
+	}
+	for i, scenario := range s.Scenarios {
+		inputs := s.Base
+		for name, value := range scenario.Overrides {
+			inputs = inputs.with(name, value)
+		}
+		cashflows := s.Model(inputs)
+		irr, err := cf.IRR(cashflows)
+		if err != nil {
+			irr = math.NaN()
+		}
+		c.Outcomes[i] = Outcome{
+			Name:              scenario.Name,
+			Probability:       scenario.Probability,
+			Cashflows:         cashflows,
+			NPV:               cf.NPV(cashflows, s.DiscountRate),
+			IRR:               irr,
+			MIRR:              cf.MIRR(cashflows, s.DiscountRate),
+			Payback:           cf.PaybackPeriod(cashflows),
+			DiscountedPayback: cf.DiscountedPaybackPeriod(cashflows, s.DiscountRate),
+		}
+	}
+	if !weighted {
+		return c, nil
+	}
+
+	expected, variance := 0.0, 0.0
+	for _, outcome := range c.Outcomes {
+		expected += outcome.Probability * outcome.NPV
+	}
+	for _, outcome := range c.Outcomes {
+		variance += outcome.Probability * math.Pow(outcome.NPV-expected, 2)
+	}
+	c.ExpectedNPV = expected
+	c.StdDev = math.Sqrt(variance)
+	c.CV = c.StdDev / expected
+	return c, nil
+}
+
+// String implements the fmt.Stringer interface by rendering a comparison
+// table of the scenarios.
+func (c Comparison) String() string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Scenario\tProb\tNPV\tIRR\tMIRR\tPayback\tDisc Payback\t")
+	for _, o := range c.Outcomes {
+		fmt.Fprintf(w, "%s\t%.2f\t%.2f\t%.2f%%\t%.2f%%\t%.2f\t%.2f\t\n",
+			o.Name,
+			o.Probability,
+			o.NPV,
+			o.IRR*100,
+			o.MIRR*100,
+			o.Payback,
+			o.DiscountedPayback,
+		)
+	}
+	if !math.IsNaN(c.ExpectedNPV) {
+		fmt.Fprintf(w, "Expected\t\t%.2f\t\t\t\t\t\n", c.ExpectedNPV)
+		fmt.Fprintf(w, "Std Dev\t\t%.2f\t\t\t\t\t\n", c.StdDev)
+	}
+	w.Flush()
+	return sb.String()
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestScenariosEvaluate(t *testing.T) {
+	s := Scenarios{
+		Model:        testModel,
+		Base:         testInputs,
+		DiscountRate: 0.10,
+		Scenarios: []Scenario{
+			{Name: "Worst", Probability: 0.25, Overrides: Inputs{"price": 9}},
+			{Name: "Base", Probability: 0.50},
+			{Name: "Best", Probability: 0.25, Overrides: Inputs{"price": 11}},
+		},
+	}
+	got, err := s.Evaluate()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	expectedNPVs := []float64{-253.944403, -5.259204, 243.425995}
+	for i, npv := range expectedNPVs {
+		if !almostEqual(got.Outcomes[i].NPV, npv) {
+			t.Errorf("%s NPV = %f, expected = %f", got.Outcomes[i].Name, got.Outcomes[i].NPV, npv)
+		}
+	}
+	if !almostEqual(got.Outcomes[2].Payback, 2.0) {
+		t.Errorf("best case payback = %f, expected = 2", got.Outcomes[2].Payback)
+	}
+	if got.Outcomes[0].IRR >= 0.10 || got.Outcomes[2].IRR <= 0.10 {
+		t.Errorf("IRRs = %f/%f, expected below/above 10%%", got.Outcomes[0].IRR, got.Outcomes[2].IRR)
+	}
+	if !almostEqual(got.ExpectedNPV, -5.259204) {
+		t.Errorf("expected NPV = %f, expected = %f", got.ExpectedNPV, -5.259204)
+	}
+	if !almostEqual(got.StdDev, 175.846991) {
+		t.Errorf("standard deviation = %f, expected = %f", got.StdDev, 175.846991)
+	}
+	table := got.String()
+	for _, want := range []string{"Scenario", "Worst", "Best", "243.43", "Expected", "175.85"} {
+		if !strings.Contains(table, want) {
+			t.Errorf("table missing %q:\n%s", want, table)
+		}
+	}
+}
+
+func TestScenariosUnweighted(t *testing.T) {
+	s := Scenarios{
+		Model:        testModel,
+		Base:         testInputs,
+		DiscountRate: 0.10,
+		Scenarios: []Scenario{
+			{Name: "Base"},
+			{Name: "No sales", Overrides: Inputs{"units": 0}},
+		},
+	}
+	got, err := s.Evaluate()
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if !math.IsNaN(got.ExpectedNPV) || !math.IsNaN(got.StdDev) {
+		t.Errorf("expected NaN expected NPV and std dev, got %f and %f", got.ExpectedNPV, got.StdDev)
+	}
+	if !math.IsNaN(got.Outcomes[1].IRR) {
+		t.Errorf("expected NaN IRR with no sales, got %f", got.Outcomes[1].IRR)
+	}
+	if strings.Contains(got.String(), "Expected") {
+		t.Errorf("unweighted table should not include an expected NPV")
+	}
+}
+
+func TestScenariosErrors(t *testing.T) {
+	testCases := []Scenarios{
+		{Model: testModel, Base: testInputs, Scenarios: []Scenario{{Name: "A", Probability: 0.5}, {Name: "B", Probability: 0.6}}},
+		{Model: testModel, Base: testInputs, Scenarios: []Scenario{{Name: "A", Probability: -0.5}}},
+		{Model: testModel, Base: testInputs, Scenarios: []Scenario{{Name: "A", Overrides: Inputs{"tax": 0.3}}}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.Evaluate(); err == nil {
+			t.Errorf("expected an error for %+v", tc.Scenarios)
+		}
+	}
+}
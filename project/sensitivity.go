@@ -0,0 +1,157 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/goinvest/fin/cf"
+)
+
+// Inputs maps the name of each model input to its value.
+type Inputs map[string]float64
+
+// with returns a copy of the inputs with the named input set to the value.
+func (in Inputs) with(name string, value float64) Inputs {
+	out := make(Inputs, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	out[name] = value
+	return out
+}
+
+// Model maps the inputs into the cashflows for periods 0 through n.
+type Model func(Inputs) []float64
+
+// Metric evaluates the cashflows produced by a model, such as the NPV or the
+// IRR.
+type Metric func(cashflows []float64) (float64, error)
+
+// NPVMetric returns a Metric that calculates the NPV of the cashflows using the
+// discount rate (k).
+func NPVMetric(k float64) Metric {
+	return func(cashflows []float64) (float64, error) {
+		return cf.NPV(cashflows, k), nil
+	}
+}
+
+// IRRMetric returns a Metric that calculates the IRR of the cashflows using the
+// optional IRR options.
+func IRRMetric(opts ...cf.IRROptions) Metric {
+	return func(cashflows []float64) (float64, error) {
+		return cf.IRR(cashflows, opts...)
+	}
+}
+
+// Perturbation models the low and high values used for a one-at-a-time
+// sensitivity of the named input. If Percent is true, Low and High are the
+// fractional changes from the base value (e.g., -0.10 and 0.10 for ±10%);
+// otherwise, they are the input values.
+type Perturbation struct {
+	Name    string
+	Low     float64
+	High    float64
+	Percent bool
+}
+
+// values returns the low and high input values for the perturbation.
+func (p Perturbation) values(base float64) (float64, float64) {
+	if p.Percent {
+		return base * (1 + p.Low), base * (1 + p.High)
+	}
+	return p.Low, p.High
+}
+
+// Swing contains the result of perturbing a single input, holding all other
+// inputs at their base values.
+type Swing struct {
+	Name      string
+	LowInput  float64
+	HighInput float64
+	Low       float64 // Metric value at the low input
+	High      float64 // Metric value at the high input
+	Swing     float64 // Absolute difference between the High and Low
+}
+
+// Tornado contains the metric for the base inputs and the swings for each
+// perturbed input ordered from largest to smallest swing.
+type Tornado struct {
+	Base   float64
+	Swings []Swing
+}
+
+// Sensitivity performs a one-at-a-time deterministic sensitivity analysis by
+// perturbing each input over its low and high values while holding the other
+// inputs at their base values. The resulting swings are in tornado order
+// (i.e., largest swing first).
+func Sensitivity(model Model, base Inputs, perturbations []Perturbation, metric Metric) (Tornado, error) {
+	baseValue, err := metric(model(base))
+	if err != nil {
+		return Tornado{}, fmt.Errorf("evaluating base inputs: %s", err)
+	}
+	swings := make([]Swing, len(perturbations))
+	for i, p := range perturbations {
+		baseInput, ok := base[p.Name]
+		if !ok {
+			return Tornado{}, fmt.Errorf("unknown input %s", p.Name)
+		}
+		lowInput, highInput := p.values(baseInput)
+		low, err := metric(model(base.with(p.Name, lowInput)))
+		if err != nil {
+			return Tornado{}, fmt.Errorf("evaluating low %s: %s", p.Name, err)
+		}
+		high, err := metric(model(base.with(p.Name, highInput)))
+		if err != nil {
+			return Tornado{}, fmt.Errorf("evaluating high %s: %s", p.Name, err)
+		}
+		swings[i] = Swing{
+			Name:      p.Name,
+			LowInput:  lowInput,
+			HighInput: highInput,
+			Low:       low,
+			High:      high,
+			Swing:     math.Abs(high - low),
+		}
+	}
+	sort.SliceStable(swings, func(i, j int) bool {
+		return swings[i].Swing > swings[j].Swing
+	})
+	return Tornado{Base: baseValue, Swings: swings}, nil
+}
+
+// SpiderPoint contains the metric value for a fractional change in an input
+// from its base value.
+type SpiderPoint struct {
+	Change float64
+	Value  float64
+}
+
+// Spider calculates the spider plot data for each of the named inputs by
+// applying each of the fractional changes (e.g., -0.20, -0.10, 0, 0.10, 0.20)
+// to the input's base value while holding the other inputs at their base
+// values.
+func Spider(model Model, base Inputs, names []string, changes []float64, metric Metric) (map[string][]SpiderPoint, error) {
+	spider := make(map[string][]SpiderPoint, len(names))
+	for _, name := range names {
+		baseInput, ok := base[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown input %s", name)
+		}
+		points := make([]SpiderPoint, len(changes))
+		for i, change := range changes {
+			value, err := metric(model(base.with(name, baseInput*(1+change))))
+			if err != nil {
+				return nil, fmt.Errorf("evaluating %s change %f: %s", name, change, err)
+			}
+			points[i] = SpiderPoint{Change: change, Value: value}
+		}
+		spider[name] = points
+	}
+	return spider, nil
+}
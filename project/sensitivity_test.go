@@ -0,0 +1,97 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package project
+
+import (
+	"testing"
+)
+
+func testModel(in Inputs) []float64 {
+	ocf := (in["price"] - in["cost"]) * in["units"]
+	return []float64{-in["investment"], ocf, ocf, ocf}
+}
+
+var testInputs = Inputs{"price": 10, "cost": 6, "units": 100, "investment": 1000}
+
+func TestSensitivity(t *testing.T) {
+	perturbations := []Perturbation{
+		{Name: "investment", Low: 900, High: 1100},
+		{Name: "units", Low: -0.10, High: 0.10, Percent: true},
+		{Name: "price", Low: -0.10, High: 0.10, Percent: true},
+	}
+	got, err := Sensitivity(testModel, testInputs, perturbations, NPVMetric(0.0))
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	if !almostEqual(got.Base, 200) {
+		t.Errorf("base NPV = %f, expected = 200", got.Base)
+	}
+	expected := []Swing{
+		{"price", 9, 11, -100, 500, 600},
+		{"units", 90, 110, 80, 320, 240},
+		{"investment", 900, 1100, 300, 100, 200},
+	}
+	if len(got.Swings) != len(expected) {
+		t.Fatalf("number of swings = %d, expected = %d", len(got.Swings), len(expected))
+	}
+	for i, want := range expected {
+		swing := got.Swings[i]
+		if swing.Name != want.Name {
+			t.Errorf("swing %d name = %s, expected = %s", i, swing.Name, want.Name)
+		}
+		if !almostEqual(swing.LowInput, want.LowInput) || !almostEqual(swing.HighInput, want.HighInput) {
+			t.Errorf("%s inputs = %f/%f, expected = %f/%f", want.Name, swing.LowInput, swing.HighInput, want.LowInput, want.HighInput)
+		}
+		if !almostEqual(swing.Low, want.Low) || !almostEqual(swing.High, want.High) {
+			t.Errorf("%s values = %f/%f, expected = %f/%f", want.Name, swing.Low, swing.High, want.Low, want.High)
+		}
+		if !almostEqual(swing.Swing, want.Swing) {
+			t.Errorf("%s swing = %f, expected = %f", want.Name, swing.Swing, want.Swing)
+		}
+	}
+
+	// The base inputs must not be changed by the analysis.
+	if testInputs["price"] != 10 || testInputs["units"] != 100 {
+		t.Errorf("base inputs were modified: %v", testInputs)
+	}
+}
+
+func TestSensitivityErrors(t *testing.T) {
+	if _, err := Sensitivity(testModel, testInputs, []Perturbation{{Name: "tax"}}, NPVMetric(0.1)); err == nil {
+		t.Errorf("expected an error for an unknown input")
+	}
+	perturbations := []Perturbation{{Name: "investment", Low: -1000, High: 1000}}
+	if _, err := Sensitivity(testModel, testInputs, perturbations, IRRMetric()); err == nil {
+		t.Errorf("expected an error when the IRR cannot be calculated")
+	}
+}
+
+func TestSpider(t *testing.T) {
+	changes := []float64{-0.10, 0.0, 0.10}
+	got, err := Spider(testModel, testInputs, []string{"price", "units"}, changes, NPVMetric(0.0))
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+	expected := map[string][]float64{
+		"price": {-100, 200, 500},
+		"units": {80, 200, 320},
+	}
+	for name, values := range expected {
+		points := got[name]
+		if len(points) != len(values) {
+			t.Errorf("%s number of points = %d, expected = %d", name, len(points), len(values))
+			continue
+		}
+		for i, value := range values {
+			if points[i].Change != changes[i] || !almostEqual(points[i].Value, value) {
+				t.Errorf("%s point %d = %+v, expected = {%f %f}", name, i, points[i], changes[i], value)
+			}
+		}
+	}
+	if _, err := Spider(testModel, testInputs, []string{"tax"}, changes, NPVMetric(0.1)); err == nil {
+		t.Errorf("expected an error for an unknown input")
+	}
+}
@@ -19,6 +19,29 @@ func ReturnOnEquity(netProfit, equity float64) float64 {
 	return netProfit / equity
 }
 
+// ReturnOnAssets tells what percentage of profit is made for every dollar of
+// assets.
+func ReturnOnAssets(netProfit, assets float64) float64 {
+	return netProfit / assets
+}
+
+// GrossMargin measures the gross profit (revenue less cost of goods sold) as
+// a percentage of revenue.
+func GrossMargin(grossProfit, revenue float64) float64 {
+	return grossProfit / revenue
+}
+
+// OperatingMargin measures the operating profit (EBIT) as a percentage of
+// revenue.
+func OperatingMargin(operatingProfit, revenue float64) float64 {
+	return operatingProfit / revenue
+}
+
+// NetMargin measures the net profit as a percentage of revenue.
+func NetMargin(netProfit, revenue float64) float64 {
+	return netProfit / revenue
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 //
 // Leverage Ratios
@@ -94,6 +117,12 @@ func QuickRatio(currentAssets, currentLiabilities, inventory float64) float64 {
 	return (currentAssets - inventory) / currentLiabilities
 }
 
+// OperatingCashFlowRatio measures how well current liabilities are covered by
+// the cash flow from operations.
+func OperatingCashFlowRatio(operatingCashFlow, currentLiabilities float64) float64 {
+	return operatingCashFlow / currentLiabilities
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 //
 // Efficiency Ratios
@@ -29,6 +29,78 @@ func TestReturnOnEquity(t *testing.T) {
 	}
 }
 
+func TestReturnOnAssets(t *testing.T) {
+	testCases := []struct {
+		netProfit float64
+		assets    float64
+		want      float64
+	}{
+		{10.0, 2.0, 5.0},
+		{250.0, 125.0, 2.0},
+	}
+	for i, test := range testCases {
+		name := fmt.Sprintf("return_on_assets_%d", i)
+		t.Run(name, func(t *testing.T) {
+			got := ReturnOnAssets(test.netProfit, test.assets)
+			assertFloat64(t, name, got, test.want, 0.0001)
+		})
+	}
+}
+
+func TestGrossMargin(t *testing.T) {
+	testCases := []struct {
+		grossProfit float64
+		revenue     float64
+		want        float64
+	}{
+		{10.0, 2.0, 5.0},
+		{250.0, 125.0, 2.0},
+	}
+	for i, test := range testCases {
+		name := fmt.Sprintf("gross_margin_%d", i)
+		t.Run(name, func(t *testing.T) {
+			got := GrossMargin(test.grossProfit, test.revenue)
+			assertFloat64(t, name, got, test.want, 0.0001)
+		})
+	}
+}
+
+func TestOperatingMargin(t *testing.T) {
+	testCases := []struct {
+		operatingProfit float64
+		revenue         float64
+		want            float64
+	}{
+		{10.0, 2.0, 5.0},
+		{250.0, 125.0, 2.0},
+	}
+	for i, test := range testCases {
+		name := fmt.Sprintf("operating_margin_%d", i)
+		t.Run(name, func(t *testing.T) {
+			got := OperatingMargin(test.operatingProfit, test.revenue)
+			assertFloat64(t, name, got, test.want, 0.0001)
+		})
+	}
+}
+
+func TestNetMargin(t *testing.T) {
+	testCases := []struct {
+		netProfit float64
+		revenue   float64
+		want      float64
+	}{
+		{10.0, 2.0, 5.0},
+		{250.0, 125.0, 2.0},
+	}
+	for i, test := range testCases {
+		name := fmt.Sprintf("net_margin_%d", i)
+		t.Run(name, func(t *testing.T) {
+			got := NetMargin(test.netProfit, test.revenue)
+			assertFloat64(t, name, got, test.want, 0.0001)
+		})
+	}
+}
+
 func TestDebtToAssets(t *testing.T) {
 	testCases := []struct {
 		debt   float64
@@ -192,6 +264,24 @@ func TestQuickRatio(t *testing.T) {
 	}
 }
 
+func TestOperatingCashFlowRatio(t *testing.T) {
+	testCases := []struct {
+		operatingCashFlow  float64
+		currentLiabilities float64
+		want               float64
+	}{
+		{10.0, 2.0, 5.0},
+		{250.0, 125.0, 2.0},
+	}
+	for i, test := range testCases {
+		name := fmt.Sprintf("operating_cash_flow_ratio_%d", i)
+		t.Run(name, func(t *testing.T) {
+			got := OperatingCashFlowRatio(test.operatingCashFlow, test.currentLiabilities)
+			assertFloat64(t, name, got, test.want, 0.0001)
+		})
+	}
+}
+
 func TestDaysInInventory(t *testing.T) {
 	testCases := []struct {
 		inventory float64
@@ -0,0 +1,232 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package retire
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/goinvest/fin/goalseek"
+)
+
+// Account is the tax treatment of a savings account.
+type Account int
+
+// Account tax treatments.
+//
+// TaxDeferred accounts receive contributions before tax, grow tax-free, and
+// tax withdrawals as income. Taxable accounts receive contributions after
+// tax, tax investment returns each year, and make withdrawals tax-free.
+const (
+	TaxDeferred Account = 1
+	Taxable     Account = 2
+)
+
+func (a Account) String() string {
+	switch a {
+	case TaxDeferred:
+		return "tax-deferred"
+	case Taxable:
+		return "taxable"
+	}
+	return fmt.Sprintf("Account(%d)", int(a))
+}
+
+// Plan models saving for and spending in retirement using annual periods from
+// the current Age until the EndAge. While working, the SavingsRate of the
+// Salary is contributed at the end of each year, and the employer matches
+// the EmployerMatch fraction of the contributions up to the MatchLimit
+// fraction of the Salary; a zero MatchLimit matches all contributions. The
+// Salary grows at the SalaryGrowth rate. From the RetirementAge, the
+// after-tax Withdrawal, stated in today's dollars and grown with Inflation,
+// is taken at the start of each year. The Balance earns the nominal Return,
+// and the TaxRate applies according to the Account.
+type Plan struct {
+	Age           int
+	RetirementAge int
+	EndAge        int
+	Balance       float64
+	Salary        float64
+	SalaryGrowth  float64
+	SavingsRate   float64
+	EmployerMatch float64
+	MatchLimit    float64
+	Return        float64
+	Inflation     float64
+	Withdrawal    float64
+	Account       Account
+	TaxRate       float64
+}
+
+// Year contains the projection for a single year. The Contribution and Match
+// are the amounts deposited after any tax, the Withdrawal is the after-tax
+// amount spent, and the Tax is the tax on withdrawals or returns. The
+// Shortfall is the portion of the Withdrawal that the Balance could not fund.
+// The Balance is the nominal balance at the end of the year, and the
+// RealBalance is the Balance in today's dollars.
+type Year struct {
+	Age          int
+	Salary       float64
+	Contribution float64
+	Match        float64
+	Withdrawal   float64
+	Tax          float64
+	Growth       float64
+	Shortfall    float64
+	Balance      float64
+	RealBalance  float64
+}
+
+// Project projects the plan year by year until the end age.
+func (p Plan) Project() ([]Year, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	years, _ := p.simulate(true)
+	return years, nil
+}
+
+// validate checks that the plan can be projected.
+func (p Plan) validate() error {
+	if p.Age > p.RetirementAge || p.RetirementAge > p.EndAge {
+		return fmt.Errorf("ages must satisfy age %d <= retirement age %d <= end age %d",
+			p.Age, p.RetirementAge, p.EndAge)
+	}
+	if p.Account != TaxDeferred && p.Account != Taxable {
+		return fmt.Errorf("unknown account %s", p.Account)
+	}
+	if p.TaxRate < 0.0 || p.TaxRate >= 1.0 {
+		return fmt.Errorf("tax rate %f outside of [0, 1)", p.TaxRate)
+	}
+	return nil
+}
+
+// simulate projects the plan and returns the years and the ending balance.
+// If depletable is false, the balance may go negative instead of recording a
+// shortfall, which makes the ending balance continuous and monotone in the
+// savings rate and the withdrawal. It is only piecewise linear, since the
+// growth of a taxable account is taxed only when positive.
+func (p Plan) simulate(depletable bool) ([]Year, float64) {
+	years := make([]Year, 0, p.EndAge-p.Age)
+	balance := p.Balance
+	for i, age := 0, p.Age; age < p.EndAge; i, age = i+1, age+1 {
+		y := Year{Age: age}
+		prices := math.Pow(1+p.Inflation, float64(i))
+		if age < p.RetirementAge {
+			y.Salary = p.Salary * math.Pow(1+p.SalaryGrowth, float64(i))
+			y.Contribution = p.SavingsRate * y.Salary
+			matched := y.Contribution
+			if p.MatchLimit > 0.0 {
+				matched = math.Min(matched, p.MatchLimit*y.Salary)
+			}
+			y.Match = p.EmployerMatch * matched
+			if p.Account == Taxable {
+				y.Tax = p.TaxRate * (y.Contribution + y.Match)
+				y.Contribution *= 1 - p.TaxRate
+				y.Match *= 1 - p.TaxRate
+			}
+		} else {
+			y.Withdrawal = p.Withdrawal * prices
+			gross := y.Withdrawal
+			if p.Account == TaxDeferred {
+				gross = y.Withdrawal / (1 - p.TaxRate)
+			}
+			if depletable && gross > balance {
+				y.Shortfall = y.Withdrawal * (gross - math.Max(balance, 0)) / gross
+				y.Withdrawal -= y.Shortfall
+				gross = math.Max(balance, 0)
+			}
+			y.Tax = gross - y.Withdrawal
+			balance -= gross
+		}
+		y.Growth = p.Return * balance
+		if p.Account == Taxable && y.Growth > 0.0 {
+			y.Tax += p.TaxRate * y.Growth
+			y.Growth *= 1 - p.TaxRate
+		}
+		balance += y.Growth + y.Contribution + y.Match
+		y.Balance = balance
+		y.RealBalance = balance / (prices * (1 + p.Inflation))
+		years = append(years, y)
+	}
+	return years, balance
+}
+
+// RequiredSavingsRate solves for the savings rate that funds the withdrawals
+// until the end age, leaving the legacy (in nominal dollars) at the end.
+func (p Plan) RequiredSavingsRate(legacy float64) (float64, error) {
+	if err := p.validate(); err != nil {
+		return math.NaN(), err
+	}
+	if p.Age == p.RetirementAge {
+		return math.NaN(), fmt.Errorf("no working years to save")
+	}
+	return p.solve(legacy, func(x float64) Plan {
+		q := p
+		q.SavingsRate = x
+		return q
+	})
+}
+
+// SustainableWithdrawal solves for the after-tax annual withdrawal, in
+// today's dollars, that the plan can fund until the end age, leaving the
+// legacy (in nominal dollars) at the end.
+func (p Plan) SustainableWithdrawal(legacy float64) (float64, error) {
+	if err := p.validate(); err != nil {
+		return math.NaN(), err
+	}
+	if p.RetirementAge == p.EndAge {
+		return math.NaN(), fmt.Errorf("no retirement years to withdraw")
+	}
+	return p.solve(legacy, func(x float64) Plan {
+		q := p
+		q.Withdrawal = x
+		return q
+	})
+}
+
+// maxExpansions is the number of times solve doubles the interval searched
+// for the plan input before giving up.
+const maxExpansions = 64
+
+// solve seeks the value of the plan input that gives the legacy as the
+// ending balance.
+func (p Plan) solve(legacy float64, with func(float64) Plan) (float64, error) {
+	residual := func(x float64) float64 {
+		_, balance := with(x).simulate(false)
+		return balance - legacy
+	}
+	// The ending balance is monotone but only piecewise linear in the input,
+	// so bracket the root by expanding an interval toward the smaller
+	// residual, then bisect it. A small change in the savings rate moves the
+	// ending balance by many dollars, so bisect to a tight relative tolerance.
+	lo, hi := 0.0, 1.0
+	fLo, fHi := residual(lo), residual(hi)
+	for i := 0; math.Signbit(fLo) == math.Signbit(fHi) && fLo != 0.0 && fHi != 0.0; i++ {
+		if i == maxExpansions {
+			return math.NaN(), fmt.Errorf("no value leaves a legacy of %f", legacy)
+		}
+		if math.Abs(fLo) < math.Abs(fHi) {
+			lo -= hi - lo
+			fLo = residual(lo)
+		} else {
+			hi += hi - lo
+			fHi = residual(hi)
+		}
+	}
+	result, err := goalseek.Seek(residual, 0.0, goalseek.Options{
+		Method:        goalseek.Bisection,
+		Lower:         lo,
+		Upper:         hi,
+		Tolerance:     1e-14,
+		ToleranceType: goalseek.Relative,
+		MaxIterations: 200,
+	})
+	if err != nil {
+		return math.NaN(), err
+	}
+	return result.X, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package retire
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goinvest/fin/cf"
+)
+
+const tolerance = 0.0001
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) <= tolerance
+}
+
+func TestPlanProjectSaving(t *testing.T) {
+	testCases := []struct {
+		name     string
+		plan     Plan
+		match    float64
+		expected float64
+	}{
+		{
+			"no_match",
+			Plan{Age: 30, RetirementAge: 65, EndAge: 65, Balance: 10000, Salary: 50000,
+				SavingsRate: 0.10, Return: 0.06, Account: TaxDeferred},
+			0, cf.FV(0.06, 35, -5000, -10000, false),
+		},
+		{
+			"capped_match",
+			Plan{Age: 30, RetirementAge: 65, EndAge: 65, Balance: 10000, Salary: 50000,
+				SavingsRate: 0.10, EmployerMatch: 0.5, MatchLimit: 0.06, Return: 0.06, Account: TaxDeferred},
+			1500, cf.FV(0.06, 35, -6500, -10000, false),
+		},
+		{
+			"taxable_no_return",
+			Plan{Age: 30, RetirementAge: 40, EndAge: 40, Salary: 50000,
+				SavingsRate: 0.10, EmployerMatch: 1.0, Account: Taxable, TaxRate: 0.25},
+			3750, 75000,
+		},
+	}
+	for _, tc := range testCases {
+		years, err := tc.plan.Project()
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if len(years) != tc.plan.EndAge-tc.plan.Age {
+			t.Fatalf("%s: years = %d, expected = %d", tc.name, len(years), tc.plan.EndAge-tc.plan.Age)
+		}
+		if !almostEqual(years[0].Match, tc.match) {
+			t.Errorf("%s: match = %f, expected = %f", tc.name, years[0].Match, tc.match)
+		}
+		if got := years[len(years)-1].Balance; !almostEqual(got, tc.expected) {
+			t.Errorf("%s: ending balance = %f, expected = %f", tc.name, got, tc.expected)
+		}
+	}
+}
+
+func TestPlanSustainableWithdrawal(t *testing.T) {
+	testCases := []struct {
+		name     string
+		plan     Plan
+		expected float64
+	}{
+		{
+			"level",
+			Plan{Age: 65, RetirementAge: 65, EndAge: 95, Balance: 1000000, Return: 0.05, Account: TaxDeferred},
+			-cf.PMT(0.05, 30, 1000000, 0, true),
+		},
+		{
+			// Withdrawals growing with inflation are a level annuity at the
+			// real return.
+			"inflation",
+			Plan{Age: 65, RetirementAge: 65, EndAge: 95, Balance: 1000000, Return: 0.05, Inflation: 0.02, Account: TaxDeferred},
+			-cf.PMT(cf.RealRate(0.05, 0.02), 30, 1000000, 0, true),
+		},
+		{
+			"taxed",
+			Plan{Age: 65, RetirementAge: 65, EndAge: 95, Balance: 1000000, Return: 0.05, Account: TaxDeferred, TaxRate: 0.20},
+			-0.80 * cf.PMT(0.05, 30, 1000000, 0, true),
+		},
+	}
+	for _, tc := range testCases {
+		got, err := tc.plan.SustainableWithdrawal(0)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		if !almostEqual(got, tc.expected) {
+			t.Errorf("%s: withdrawal = %f, expected = %f", tc.name, got, tc.expected)
+		}
+		tc.plan.Withdrawal = got
+		years, _ := tc.plan.Project()
+		last := years[len(years)-1]
+		if math.Abs(last.Balance) > 0.001 || last.Shortfall > 0.001 {
+			t.Errorf("%s: ending balance = %f, shortfall = %f, expected zero", tc.name, last.Balance, last.Shortfall)
+		}
+	}
+}
+
+func TestPlanRequiredSavingsRate(t *testing.T) {
+	plan := Plan{
+		Age:           35,
+		RetirementAge: 65,
+		EndAge:        95,
+		Balance:       50000,
+		Salary:        80000,
+		SalaryGrowth:  0.03,
+		EmployerMatch: 0.5,
+		MatchLimit:    0.06,
+		Return:        0.06,
+		Inflation:     0.025,
+		Withdrawal:    60000,
+		Account:       TaxDeferred,
+		TaxRate:       0.15,
+	}
+	for _, legacy := range []float64{0, 500000} {
+		rate, err := plan.RequiredSavingsRate(legacy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := plan
+		p.SavingsRate = rate
+		years, _ := p.Project()
+		for _, y := range years {
+			if y.Shortfall > 0.001 {
+				t.Errorf("legacy %f: shortfall = %f at age %d", legacy, y.Shortfall, y.Age)
+			}
+		}
+		if got := years[len(years)-1].Balance; math.Abs(got-legacy) > 0.001 {
+			t.Errorf("legacy %f: ending balance = %f", legacy, got)
+		}
+	}
+
+	// Saving less than the required rate runs out of money.
+	rate, _ := plan.RequiredSavingsRate(0)
+	plan.SavingsRate = rate - 0.02
+	years, _ := plan.Project()
+	last := years[len(years)-1]
+	if last.Shortfall <= 0.0 || last.Balance != 0.0 {
+		t.Errorf("underfunded shortfall = %f, balance = %f, expected a shortfall", last.Shortfall, last.Balance)
+	}
+}
+
+func TestPlanAccounts(t *testing.T) {
+	plan := Plan{Age: 30, RetirementAge: 65, EndAge: 95, Salary: 60000, SavingsRate: 0.10,
+		Account: TaxDeferred, TaxRate: 0.25}
+	taxable := plan
+	taxable.Account = Taxable
+
+	// Without investment returns, both accounts are taxed once.
+	deferred, _ := plan.SustainableWithdrawal(0)
+	other, _ := taxable.SustainableWithdrawal(0)
+	if !almostEqual(deferred, other) {
+		t.Errorf("withdrawals without returns: tax-deferred = %f, taxable = %f", deferred, other)
+	}
+
+	// With investment returns, the taxable account suffers a tax drag.
+	plan.Return, taxable.Return = 0.06, 0.06
+	deferred, _ = plan.SustainableWithdrawal(0)
+	other, _ = taxable.SustainableWithdrawal(0)
+	if deferred <= other {
+		t.Errorf("withdrawals with returns: tax-deferred = %f, expected more than taxable = %f", deferred, other)
+	}
+
+	// Growth is taxed only when positive, so the ending balance of the
+	// taxable account is not linear in the withdrawal.
+	taxable.Withdrawal = other
+	years, _ := taxable.Project()
+	last := years[len(years)-1]
+	if math.Abs(last.Balance) > 0.001 || last.Shortfall > 0.001 {
+		t.Errorf("taxable ending balance = %f, shortfall = %f, expected zero", last.Balance, last.Shortfall)
+	}
+}
+
+func TestPlanErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		plan Plan
+	}{
+		{"retire_before_age", Plan{Age: 65, RetirementAge: 60, EndAge: 90, Account: TaxDeferred}},
+		{"end_before_retire", Plan{Age: 30, RetirementAge: 65, EndAge: 60, Account: TaxDeferred}},
+		{"no_account", Plan{Age: 30, RetirementAge: 65, EndAge: 90}},
+		{"tax_rate", Plan{Age: 30, RetirementAge: 65, EndAge: 90, Account: Taxable, TaxRate: 1.0}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.plan.Project(); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+	retired := Plan{Age: 65, RetirementAge: 65, EndAge: 90, Account: TaxDeferred}
+	if rate, err := retired.RequiredSavingsRate(0); err == nil || !math.IsNaN(rate) {
+		t.Errorf("expected NaN and an error without working years, got %f", rate)
+	}
+	working := Plan{Age: 30, RetirementAge: 65, EndAge: 65, Account: TaxDeferred}
+	if withdrawal, err := working.SustainableWithdrawal(0); err == nil || !math.IsNaN(withdrawal) {
+		t.Errorf("expected NaN and an error without retirement years, got %f", withdrawal)
+	}
+	// Without a salary, no savings rate leaves a legacy.
+	unpaid := Plan{Age: 30, RetirementAge: 65, EndAge: 90, Account: TaxDeferred}
+	if rate, err := unpaid.RequiredSavingsRate(100000); err == nil || !math.IsNaN(rate) {
+		t.Errorf("expected NaN and an error without a salary, got %f", rate)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package fin
+
+import (
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/stat"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+//
+// Risk-Adjusted Performance
+//
+// The risk-adjusted performance measures operate on periodic (e.g., monthly)
+// return series, and the risk-free rate, minimum acceptable return (MAR), and
+// results are per period. To annualize a ratio of mean return to standard
+// deviation, multiply by the square root of the number of periods per year.
+//
+///////////////////////////////////////////////////////////////////////////////
+
+// Sharpe calculates the Sharpe ratio, which is the mean excess return over the
+// risk-free rate divided by the standard deviation of the excess returns.
+func Sharpe(returns []float64, riskFree float64) float64 {
+	excess := make([]float64, len(returns))
+	for i, r := range returns {
+		excess[i] = r - riskFree
+	}
+	mean, std := stat.MeanStdDev(excess, nil)
+	return mean / std
+}
+
+// Sortino calculates the Sortino ratio, which is the mean return in excess of
+// the minimum acceptable return (MAR) divided by the downside deviation.
+func Sortino(returns []float64, mar float64) float64 {
+	return (stat.Mean(returns, nil) - mar) / DownsideDeviation(returns, mar)
+}
+
+// DownsideDeviation calculates the downside deviation, which only includes
+// the returns falling below the minimum acceptable return (MAR).
+//
+// DD = sqrt(∑ min(0, r_i - MAR)^2 / n)
+func DownsideDeviation(returns []float64, mar float64) float64 {
+	sum := 0.0
+	for _, r := range returns {
+		if r < mar {
+			sum += (r - mar) * (r - mar)
+		}
+	}
+	return math.Sqrt(sum / float64(len(returns)))
+}
+
+// Beta calculates the sensitivity of the returns to the benchmark returns,
+// which is the covariance of the returns with the benchmark divided by the
+// variance of the benchmark.
+func Beta(returns, benchmark []float64) float64 {
+	return stat.Covariance(returns, benchmark, nil) / stat.Variance(benchmark, nil)
+}
+
+// Treynor calculates the Treynor ratio, which is the mean excess return over
+// the risk-free rate divided by the beta relative to the benchmark.
+func Treynor(returns, benchmark []float64, riskFree float64) float64 {
+	return (stat.Mean(returns, nil) - riskFree) / Beta(returns, benchmark)
+}
+
+// InformationRatio calculates the information ratio, which is the mean active
+// return (i.e., return less the benchmark return) divided by the tracking
+// error (i.e., the standard deviation of the active returns).
+func InformationRatio(returns, benchmark []float64) float64 {
+	active := make([]float64, len(returns))
+	for i, r := range returns {
+		active[i] = r - benchmark[i]
+	}
+	mean, std := stat.MeanStdDev(active, nil)
+	return mean / std
+}
+
+// Drawdown contains the maximum drawdown of a return series, which is the
+// largest percentage decline in the cumulative value from a peak to a
+// subsequent trough. The Peak, Trough, and Recovery are indices of the
+// cumulative value, where index 0 is the start of the series and index i is
+// the end of the period for returns[i-1]. If the value never recovers to the
+// peak, the Recovery is -1.
+type Drawdown struct {
+	Max      float64
+	Peak     int
+	Trough   int
+	Recovery int
+}
+
+// Dates returns the dates of the peak, trough, and recovery given the date of
+// each cumulative value (i.e., the start date followed by the end date of each
+// period). The recovery date is the zero time if the value never recovers.
+func (d Drawdown) Dates(dates []time.Time) (peak, trough, recovery time.Time) {
+	peak, trough = dates[d.Peak], dates[d.Trough]
+	if d.Recovery >= 0 {
+		recovery = dates[d.Recovery]
+	}
+	return peak, trough, recovery
+}
+
+// MaxDrawdown calculates the maximum drawdown of the returns along with when
+// the drawdown occurred.
+func MaxDrawdown(returns []float64) Drawdown {
+	dd := Drawdown{Recovery: -1}
+	value, peakValue, peak := 1.0, 1.0, 0
+	for i, r := range returns {
+		value *= 1 + r
+		if value >= peakValue {
+			if dd.Max > 0.0 && dd.Recovery < 0 && dd.Peak == peak {
+				dd.Recovery = i + 1
+			}
+			peakValue, peak = value, i+1
+			continue
+		}
+		if drawdown := 1 - value/peakValue; drawdown > dd.Max {
+			dd = Drawdown{Max: drawdown, Peak: peak, Trough: i + 1, Recovery: -1}
+		}
+	}
+	return dd
+}
+
+// Calmar calculates the Calmar ratio, which is the annualized return divided
+// by the maximum drawdown.
+func Calmar(returns []float64, periodsPerYear int) float64 {
+	return AnnualizedReturn(returns, periodsPerYear) / MaxDrawdown(returns).Max
+}
+
+// AnnualizedReturn calculates the compound annual return of the periodic
+// returns given the number of periods per year.
+func AnnualizedReturn(returns []float64, periodsPerYear int) float64 {
+	growth := 1.0
+	for _, r := range returns {
+		growth *= 1 + r
+	}
+	years := float64(len(returns)) / float64(periodsPerYear)
+	return math.Pow(growth, 1/years) - 1
+}
+
+// Rolling calculates the metric over each rolling window of the returns, so
+// element i of the result is the metric for returns[i : i+window]. For
+// example, a rolling 12-period Sharpe ratio is:
+//
+//	Rolling(returns, 12, func(r []float64) float64 { return Sharpe(r, rf) })
+func Rolling(returns []float64, window int, metric func([]float64) float64) []float64 {
+	if window < 1 || window > len(returns) {
+		return []float64{}
+	}
+	rolling := make([]float64, len(returns)-window+1)
+	for i := range rolling {
+		rolling[i] = metric(returns[i : i+window])
+	}
+	return rolling
+}
+
+// RollingBenchmark calculates the metric over each rolling window of the
+// returns and the benchmark returns (e.g., for the Treynor or information
+// ratio), so element i of the result is the metric for the window starting
+// at period i.
+func RollingBenchmark(returns, benchmark []float64, window int, metric func(r, b []float64) float64) []float64 {
+	if window < 1 || window > len(returns) || len(benchmark) != len(returns) {
+		return []float64{}
+	}
+	rolling := make([]float64, len(returns)-window+1)
+	for i := range rolling {
+		rolling[i] = metric(returns[i:i+window], benchmark[i:i+window])
+	}
+	return rolling
+}
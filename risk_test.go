@@ -0,0 +1,98 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package fin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+var (
+	testReturns   = []float64{0.05, -0.02, 0.03, -0.10, 0.04, 0.06, 0.02, -0.01}
+	testBenchmark = []float64{0.02, -0.01, 0.02, -0.05, 0.03, 0.04, 0.01, 0.0}
+)
+
+func TestRiskAdjustedRatios(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"sharpe", Sharpe(testReturns, 0.002), 0.129947},
+		{"sortino", Sortino(testReturns, 0.0), 0.241523},
+		{"downside_deviation", DownsideDeviation(testReturns, 0.0), 0.036228},
+		{"beta", Beta(testReturns, testBenchmark), 1.815315},
+		{"treynor", Treynor(testReturns, testBenchmark, 0.002), 0.003718},
+		{"information_ratio", InformationRatio(testReturns, testBenchmark), 0.050508},
+		{"annualized_return", AnnualizedReturn(testReturns, 12), 0.094220},
+		{"calmar", Calmar(testReturns, 12), 0.942197},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assertFloat64(t, test.name, test.got, test.want, 0.000001)
+		})
+	}
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	testCases := []struct {
+		returns []float64
+		want    Drawdown
+	}{
+		{testReturns, Drawdown{Max: 0.10, Peak: 3, Trough: 4, Recovery: 7}},
+		{[]float64{-0.10, -0.10, 0.05}, Drawdown{Max: 0.19, Peak: 0, Trough: 2, Recovery: -1}},
+		{[]float64{0.01, 0.02}, Drawdown{Max: 0.0, Peak: 0, Trough: 0, Recovery: -1}},
+		{[]float64{-0.5, 1.0, -0.6, 0.1}, Drawdown{Max: 0.6, Peak: 2, Trough: 3, Recovery: -1}},
+	}
+	for i, test := range testCases {
+		name := fmt.Sprintf("max_drawdown_%d", i)
+		t.Run(name, func(t *testing.T) {
+			got := MaxDrawdown(test.returns)
+			assertFloat64(t, name, got.Max, test.want.Max, 0.000001)
+			if got.Peak != test.want.Peak || got.Trough != test.want.Trough || got.Recovery != test.want.Recovery {
+				t.Errorf("\t got = %+v %s\n\t\t\twant = %+v", got, name, test.want)
+			}
+		})
+	}
+}
+
+func TestDrawdownDates(t *testing.T) {
+	dates := make([]time.Time, len(testReturns)+1)
+	for i := range dates {
+		dates[i] = time.Date(2024, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)
+	}
+	peak, trough, recovery := MaxDrawdown(testReturns).Dates(dates)
+	if !peak.Equal(dates[3]) || !trough.Equal(dates[4]) || !recovery.Equal(dates[7]) {
+		t.Errorf("drawdown dates = %s, %s, %s", peak, trough, recovery)
+	}
+	_, _, recovery = MaxDrawdown([]float64{0.1, -0.1}).Dates(dates)
+	if !recovery.IsZero() {
+		t.Errorf("recovery date = %s, expected zero time", recovery)
+	}
+}
+
+func TestRolling(t *testing.T) {
+	sharpe := func(r []float64) float64 { return Sharpe(r, 0.002) }
+	got := Rolling(testReturns, 4, sharpe)
+	if len(got) != 5 {
+		t.Fatalf("number of windows = %d, expected = 5", len(got))
+	}
+	assertFloat64(t, "rolling_sharpe_0", got[0], -0.179552, 0.000001)
+	assertFloat64(t, "rolling_sharpe_4", got[4], Sharpe(testReturns[4:], 0.002), 0.000001)
+	if got := Rolling(testReturns, 9, sharpe); len(got) != 0 {
+		t.Errorf("expected no windows for a window longer than the returns, got %d", len(got))
+	}
+
+	gotIR := RollingBenchmark(testReturns, testBenchmark, 8, InformationRatio)
+	if len(gotIR) != 1 {
+		t.Fatalf("number of windows = %d, expected = 1", len(gotIR))
+	}
+	assertFloat64(t, "rolling_information_ratio", gotIR[0], 0.050508, 0.000001)
+	if got := RollingBenchmark(testReturns, testBenchmark[1:], 4, InformationRatio); len(got) != 0 {
+		t.Errorf("expected no windows for mismatched lengths, got %d", len(got))
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package fin
+
+import "math"
+
+///////////////////////////////////////////////////////////////////////////////
+//
+// Financial Statements
+//
+///////////////////////////////////////////////////////////////////////////////
+
+// IncomeStatement models the standard line items of an income statement for a
+// single period. Expenses are positive amounts.
+type IncomeStatement struct {
+	Revenue           float64
+	COGS              float64 // Cost of goods sold
+	OperatingExpenses float64 // Selling, general, and administrative expenses
+	Depreciation      float64 // Depreciation and amortization
+	OtherIncome       float64 // Non-operating income, net of expenses
+	InterestExpense   float64
+	TaxExpense        float64
+}
+
+// GrossProfit calculates revenue less the cost of goods sold.
+func (is IncomeStatement) GrossProfit() float64 {
+	return is.Revenue - is.COGS
+}
+
+// EBITDA calculates the earnings before interest, taxes, depreciation, and
+// amortization.
+func (is IncomeStatement) EBITDA() float64 {
+	return is.GrossProfit() - is.OperatingExpenses
+}
+
+// EBIT calculates the earnings before interest and taxes, which is the
+// operating profit.
+func (is IncomeStatement) EBIT() float64 {
+	return is.EBITDA() - is.Depreciation
+}
+
+// PretaxIncome calculates the earnings before taxes.
+func (is IncomeStatement) PretaxIncome() float64 {
+	return is.EBIT() + is.OtherIncome - is.InterestExpense
+}
+
+// NetIncome calculates the earnings after taxes.
+func (is IncomeStatement) NetIncome() float64 {
+	return is.PretaxIncome() - is.TaxExpense
+}
+
+// BalanceSheet models the standard line items of a balance sheet at the end
+// of a period. Debt is interest-bearing, so it excludes payables and other
+// liabilities.
+type BalanceSheet struct {
+	Cash                    float64
+	Receivables             float64
+	Inventory               float64
+	OtherCurrentAssets      float64
+	PPE                     float64 // Net property, plant, and equipment
+	OtherAssets             float64
+	Payables                float64
+	ShortTermDebt           float64
+	OtherCurrentLiabilities float64
+	LongTermDebt            float64
+	OtherLiabilities        float64
+	Equity                  float64
+}
+
+// CurrentAssets calculates the total current assets.
+func (bs BalanceSheet) CurrentAssets() float64 {
+	return bs.Cash + bs.Receivables + bs.Inventory + bs.OtherCurrentAssets
+}
+
+// TotalAssets calculates the total assets.
+func (bs BalanceSheet) TotalAssets() float64 {
+	return bs.CurrentAssets() + bs.PPE + bs.OtherAssets
+}
+
+// CurrentLiabilities calculates the total current liabilities.
+func (bs BalanceSheet) CurrentLiabilities() float64 {
+	return bs.Payables + bs.ShortTermDebt + bs.OtherCurrentLiabilities
+}
+
+// Debt calculates the total short-term and long-term debt.
+func (bs BalanceSheet) Debt() float64 {
+	return bs.ShortTermDebt + bs.LongTermDebt
+}
+
+// TotalLiabilities calculates the total liabilities.
+func (bs BalanceSheet) TotalLiabilities() float64 {
+	return bs.CurrentLiabilities() + bs.LongTermDebt + bs.OtherLiabilities
+}
+
+// CashFlowStatement models the standard line items of a cash flow statement
+// for a single period. Capital expenditures and dividends are positive
+// amounts included in the investing and financing cash flows, respectively.
+type CashFlowStatement struct {
+	OperatingCashFlow   float64
+	InvestingCashFlow   float64
+	FinancingCashFlow   float64
+	CapitalExpenditures float64
+	DividendsPaid       float64
+}
+
+// FreeCashFlow calculates the operating cash flow less capital expenditures.
+func (cfs CashFlowStatement) FreeCashFlow() float64 {
+	return cfs.OperatingCashFlow - cfs.CapitalExpenditures
+}
+
+// NetChangeInCash calculates the total of the operating, investing, and
+// financing cash flows.
+func (cfs CashFlowStatement) NetChangeInCash() float64 {
+	return cfs.OperatingCashFlow + cfs.InvestingCashFlow + cfs.FinancingCashFlow
+}
+
+// Financials models the financial statements of a company for a single
+// period. The Days in the period, which default to 365, are used for the
+// days ratios.
+type Financials struct {
+	Income   IncomeStatement
+	Balance  BalanceSheet
+	CashFlow CashFlowStatement
+	Days     int
+}
+
+// RatioReport contains the financial ratios calculated from the financial
+// statements. A ratio is NaN if it is unavailable because its denominator is
+// zero, such as the interest coverage of a company without debt.
+type RatioReport struct {
+	// Profitability ratios
+	ReturnOnEquity  float64
+	ReturnOnAssets  float64
+	GrossMargin     float64
+	OperatingMargin float64
+	NetMargin       float64
+
+	// Leverage ratios
+	DebtToAssets        float64
+	DebtToEquity        float64
+	LiabilitiesToAssets float64
+	LiabilitiesToEquity float64
+	EquityMultiplier    float64
+	TimesInterestEarned float64
+	InterestCoverage    float64
+
+	// Liquidity ratios
+	CurrentRatio           float64
+	QuickRatio             float64
+	OperatingCashFlowRatio float64
+
+	// Efficiency ratios
+	DaysInInventory        float64
+	InventoryTurns         float64
+	DaysSalesOutstanding   float64
+	DaysPayableOutstanding float64
+	PPETurnover            float64
+	TotalAssetTurnover     float64
+}
+
+// Ratios calculates every available financial ratio from the financial
+// statements using the scalar ratio functions.
+func (f Financials) Ratios() RatioReport {
+	days := f.Days
+	if days == 0 {
+		days = 365
+	}
+	is, bs := f.Income, f.Balance
+	netIncome, ebit := is.NetIncome(), is.EBIT()
+	assets, liabilities, debt := bs.TotalAssets(), bs.TotalLiabilities(), bs.Debt()
+	currentAssets, currentLiabilities := bs.CurrentAssets(), bs.CurrentLiabilities()
+
+	return RatioReport{
+		ReturnOnEquity:  available(ReturnOnEquity(netIncome, bs.Equity)),
+		ReturnOnAssets:  available(ReturnOnAssets(netIncome, assets)),
+		GrossMargin:     available(GrossMargin(is.GrossProfit(), is.Revenue)),
+		OperatingMargin: available(OperatingMargin(ebit, is.Revenue)),
+		NetMargin:       available(NetMargin(netIncome, is.Revenue)),
+
+		DebtToAssets:        available(DebtToAssets(debt, assets)),
+		DebtToEquity:        available(DebtToEquity(debt, bs.Equity)),
+		LiabilitiesToAssets: available(LiabilitiesToAssets(liabilities, assets)),
+		LiabilitiesToEquity: available(LiabilitiesToEquity(liabilities, bs.Equity)),
+		EquityMultiplier:    available(EquityMultiplier(assets, bs.Equity)),
+		TimesInterestEarned: available(TimesInterestEarned(ebit, is.InterestExpense)),
+		InterestCoverage:    available(InterestCoverage(ebit, is.InterestExpense)),
+
+		CurrentRatio:           available(CurrentRatio(currentAssets, currentLiabilities)),
+		QuickRatio:             available(QuickRatio(currentAssets, currentLiabilities, bs.Inventory)),
+		OperatingCashFlowRatio: available(OperatingCashFlowRatio(f.CashFlow.OperatingCashFlow, currentLiabilities)),
+
+		DaysInInventory:        available(DaysInInventory(bs.Inventory, is.COGS, days)),
+		InventoryTurns:         available(InventoryTurns(bs.Inventory, is.COGS)),
+		DaysSalesOutstanding:   available(DaysSalesOutstanding(bs.Receivables, is.Revenue, days)),
+		DaysPayableOutstanding: available(DaysPayableOutstanding(bs.Payables, is.COGS, days)),
+		PPETurnover:            available(PPETurnover(is.Revenue, bs.PPE)),
+		TotalAssetTurnover:     available(TotalAssetTurnover(is.Revenue, assets)),
+	}
+}
+
+// available returns NaN for a ratio with a zero denominator.
+func available(ratio float64) float64 {
+	if math.IsInf(ratio, 0) {
+		return math.NaN()
+	}
+	return ratio
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package fin
+
+import (
+	"math"
+	"testing"
+)
+
+var testFinancials = Financials{
+	Income: IncomeStatement{
+		Revenue:           1000,
+		COGS:              600,
+		OperatingExpenses: 150,
+		Depreciation:      50,
+		OtherIncome:       10,
+		InterestExpense:   40,
+		TaxExpense:        50,
+	},
+	Balance: BalanceSheet{
+		Cash:                    50,
+		Receivables:             120,
+		Inventory:               80,
+		OtherCurrentAssets:      50,
+		PPE:                     600,
+		OtherAssets:             100,
+		Payables:                60,
+		ShortTermDebt:           40,
+		OtherCurrentLiabilities: 50,
+		LongTermDebt:            360,
+		OtherLiabilities:        90,
+		Equity:                  400,
+	},
+	CashFlow: CashFlowStatement{
+		OperatingCashFlow:   180,
+		InvestingCashFlow:   -100,
+		FinancingCashFlow:   -30,
+		CapitalExpenditures: 100,
+		DividendsPaid:       20,
+	},
+}
+
+func TestStatementSubtotals(t *testing.T) {
+	is, bs, cfs := testFinancials.Income, testFinancials.Balance, testFinancials.CashFlow
+	testCases := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"gross_profit", is.GrossProfit(), 400},
+		{"ebitda", is.EBITDA(), 250},
+		{"ebit", is.EBIT(), 200},
+		{"pretax_income", is.PretaxIncome(), 170},
+		{"net_income", is.NetIncome(), 120},
+		{"current_assets", bs.CurrentAssets(), 300},
+		{"total_assets", bs.TotalAssets(), 1000},
+		{"current_liabilities", bs.CurrentLiabilities(), 150},
+		{"debt", bs.Debt(), 400},
+		{"total_liabilities", bs.TotalLiabilities(), 600},
+		{"free_cash_flow", cfs.FreeCashFlow(), 80},
+		{"net_change_in_cash", cfs.NetChangeInCash(), 50},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assertFloat64(t, test.name, test.got, test.want, 0.0001)
+		})
+	}
+}
+
+func TestFinancialsRatios(t *testing.T) {
+	r := testFinancials.Ratios()
+	testCases := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"return_on_equity", r.ReturnOnEquity, 0.30},
+		{"return_on_assets", r.ReturnOnAssets, 0.12},
+		{"gross_margin", r.GrossMargin, 0.40},
+		{"operating_margin", r.OperatingMargin, 0.20},
+		{"net_margin", r.NetMargin, 0.12},
+		{"debt_to_assets", r.DebtToAssets, 0.40},
+		{"debt_to_equity", r.DebtToEquity, 1.0},
+		{"liabilities_to_assets", r.LiabilitiesToAssets, 0.60},
+		{"liabilities_to_equity", r.LiabilitiesToEquity, 1.5},
+		{"equity_multiplier", r.EquityMultiplier, 2.5},
+		{"times_interest_earned", r.TimesInterestEarned, 5.0},
+		{"interest_coverage", r.InterestCoverage, 5.0},
+		{"current_ratio", r.CurrentRatio, 2.0},
+		{"quick_ratio", r.QuickRatio, 1.466667},
+		{"operating_cash_flow_ratio", r.OperatingCashFlowRatio, 1.2},
+		{"days_in_inventory", r.DaysInInventory, 48.666667},
+		{"inventory_turns", r.InventoryTurns, 7.5},
+		{"days_sales_outstanding", r.DaysSalesOutstanding, 43.8},
+		{"days_payable_outstanding", r.DaysPayableOutstanding, 36.5},
+		{"ppe_turnover", r.PPETurnover, 1.666667},
+		{"total_asset_turnover", r.TotalAssetTurnover, 1.0},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assertFloat64(t, test.name, test.got, test.want, 0.0001)
+		})
+	}
+}
+
+func TestFinancialsRatiosOptions(t *testing.T) {
+	f := testFinancials
+	f.Days = 360
+	f.Income.InterestExpense = 0
+	r := f.Ratios()
+	assertFloat64(t, "days_in_inventory_360", r.DaysInInventory, 48, 0.0001)
+	if !math.IsNaN(r.TimesInterestEarned) || !math.IsNaN(r.InterestCoverage) {
+		t.Errorf("interest coverage without interest = %f, expected NaN", r.InterestCoverage)
+	}
+	if empty := (Financials{}).Ratios(); !math.IsNaN(empty.ReturnOnEquity) {
+		t.Errorf("return on equity of empty statements = %f, expected NaN", empty.ReturnOnEquity)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package tax
+
+import (
+	"fmt"
+	"math"
+)
+
+// Bracket taxes the income above the Threshold at the marginal Rate, up to
+// the threshold of the next bracket.
+type Bracket struct {
+	Threshold float64
+	Rate      float64
+}
+
+// Schedule is a progressive tax schedule of brackets in ascending order of
+// threshold. Income below the first threshold is not taxed.
+type Schedule []Bracket
+
+// Flat returns a schedule taxing all positive income at the rate.
+func Flat(rate float64) Schedule {
+	return Schedule{{0.0, rate}}
+}
+
+// Tax calculates the tax on the taxable income. No tax is due on zero or
+// negative income.
+func (s Schedule) Tax(income float64) float64 {
+	tax := 0.0
+	for i, b := range s {
+		if income <= b.Threshold {
+			break
+		}
+		top := income
+		if i+1 < len(s) {
+			top = math.Min(income, s[i+1].Threshold)
+		}
+		tax += b.Rate * (top - b.Threshold)
+	}
+	return tax
+}
+
+// MarginalRate returns the tax rate on the next dollar of the income.
+func (s Schedule) MarginalRate(income float64) float64 {
+	rate := 0.0
+	for _, b := range s {
+		if income < b.Threshold {
+			break
+		}
+		rate = b.Rate
+	}
+	return rate
+}
+
+// AverageRate calculates the tax as a fraction of the income.
+func (s Schedule) AverageRate(income float64) float64 {
+	if income <= 0.0 {
+		return 0.0
+	}
+	return s.Tax(income) / income
+}
+
+// validate checks that the thresholds are in ascending order.
+func (s Schedule) validate() error {
+	if len(s) == 0 {
+		return fmt.Errorf("tax schedule has no brackets")
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i].Threshold <= s[i-1].Threshold {
+			return fmt.Errorf("tax bracket thresholds not in ascending order at %f", s[i].Threshold)
+		}
+	}
+	return nil
+}
+
+// Rules models the tax rules for computing the tax on a series of periods.
+// A loss is first carried back to the earliest of the prior CarrybackYears
+// periods, producing a refund, and the rest is carried forward to offset
+// future income, oldest losses first. Losses expire if not used within
+// CarryforwardYears periods; zero means losses never expire. The
+// CarryforwardLimit limits the carryforward used in a period to a fraction of
+// the period's income (e.g., 0.80); zero means no limit.
+type Rules struct {
+	Schedule          Schedule
+	CarrybackYears    int
+	CarryforwardYears int
+	CarryforwardLimit float64
+}
+
+// Period contains the tax computation for a single period. The Tax is the tax
+// on the TaxableIncome less any Refund from carrying back the period's loss,
+// so it is negative when the refund exceeds the tax. The LossCarriedForward is
+// the unused loss available at the end of the period.
+type Period struct {
+	Income             float64
+	CarryforwardUsed   float64
+	CarriedBack        float64
+	TaxableIncome      float64
+	Refund             float64
+	Tax                float64
+	LossExpired        float64
+	LossCarriedForward float64
+}
+
+// loss is the unused portion of the loss from a period.
+type loss struct {
+	period int
+	amount float64
+}
+
+// Compute computes the tax for each period of the pre-tax income.
+func (r Rules) Compute(income []float64) ([]Period, error) {
+	if err := r.Schedule.validate(); err != nil {
+		return nil, err
+	}
+	if r.CarryforwardLimit < 0.0 || r.CarryforwardLimit > 1.0 {
+		return nil, fmt.Errorf("carryforward limit %f outside of [0, 1]", r.CarryforwardLimit)
+	}
+	if r.CarrybackYears < 0 || r.CarryforwardYears < 0 {
+		return nil, fmt.Errorf("carryback and carryforward years must not be negative")
+	}
+
+	periods := make([]Period, len(income))
+	// remaining[s] is the taxable income of period s not yet offset by losses
+	// carried back.
+	remaining := make([]float64, len(income))
+	var losses []loss
+	for t, inc := range income {
+		p := Period{Income: inc}
+
+		// Expire losses that can no longer be carried forward.
+		if r.CarryforwardYears > 0 {
+			for len(losses) > 0 && t-losses[0].period > r.CarryforwardYears {
+				p.LossExpired += losses[0].amount
+				losses = losses[1:]
+			}
+		}
+
+		if inc >= 0.0 {
+			limit := inc
+			if r.CarryforwardLimit > 0.0 {
+				limit = r.CarryforwardLimit * inc
+			}
+			for len(losses) > 0 && p.CarryforwardUsed < limit {
+				use := math.Min(losses[0].amount, limit-p.CarryforwardUsed)
+				p.CarryforwardUsed += use
+				losses[0].amount -= use
+				if losses[0].amount > 0.0 {
+					break
+				}
+				losses = losses[1:]
+			}
+			p.TaxableIncome = inc - p.CarryforwardUsed
+			remaining[t] = p.TaxableIncome
+		} else {
+			unused := -inc
+			for s := t - r.CarrybackYears; s < t && unused > 0.0; s++ {
+				if s < 0 || remaining[s] <= 0.0 {
+					continue
+				}
+				use := math.Min(unused, remaining[s])
+				p.CarriedBack += use
+				p.Refund += r.Schedule.Tax(remaining[s]) - r.Schedule.Tax(remaining[s]-use)
+				remaining[s] -= use
+				unused -= use
+			}
+			if unused > 0.0 {
+				losses = append(losses, loss{t, unused})
+			}
+		}
+		p.Tax = r.Schedule.Tax(p.TaxableIncome) - p.Refund
+		for _, l := range losses {
+			p.LossCarriedForward += l.amount
+		}
+		periods[t] = p
+	}
+	return periods, nil
+}
+
+// Taxes returns the tax for each period of the pre-tax income, which is
+// subtracted from the pre-tax cash flows before discounting.
+func (r Rules) Taxes(income []float64) ([]float64, error) {
+	periods, err := r.Compute(income)
+	if err != nil {
+		return nil, err
+	}
+	taxes := make([]float64, len(periods))
+	for t, p := range periods {
+		taxes[t] = p.Tax
+	}
+	return taxes, nil
+}
+
+// AfterTax subtracts the tax for each period from the pre-tax cash flows.
+func AfterTax(cashflows, taxes []float64) ([]float64, error) {
+	if len(taxes) != len(cashflows) {
+		return nil, fmt.Errorf("have %d taxes for %d cashflows", len(taxes), len(cashflows))
+	}
+	after := make([]float64, len(cashflows))
+	for t, cashflow := range cashflows {
+		after[t] = cashflow - taxes[t]
+	}
+	return after, nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2019-2025 The goinvest/fin developers. All rights reserved.
+// Project site: https://github.com/goinvest/fin
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE file for the project.
+
+package tax
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goinvest/fin/cf"
+)
+
+const tolerance = 0.000001
+
+func almostEqual(f1, f2 float64) bool {
+	return math.Abs(f1-f2) <= tolerance
+}
+
+var progressive = Schedule{{0, 0.10}, {50, 0.20}, {100, 0.30}}
+
+func TestScheduleTax(t *testing.T) {
+	testCases := []struct {
+		schedule Schedule
+		income   float64
+		tax      float64
+		marginal float64
+		average  float64
+	}{
+		{progressive, 150, 30, 0.30, 0.20},
+		{progressive, 75, 10, 0.20, 0.133333},
+		{progressive, 50, 5, 0.20, 0.10},
+		{progressive, -5, 0, 0.0, 0.0},
+		{Flat(0.21), 1000, 210, 0.21, 0.21},
+		{Schedule{{10, 0.10}}, 5, 0, 0.0, 0.0},
+	}
+	for _, tc := range testCases {
+		if got := tc.schedule.Tax(tc.income); !almostEqual(got, tc.tax) {
+			t.Errorf("tax on %f = %f, expected = %f", tc.income, got, tc.tax)
+		}
+		if got := tc.schedule.MarginalRate(tc.income); !almostEqual(got, tc.marginal) {
+			t.Errorf("marginal rate on %f = %f, expected = %f", tc.income, got, tc.marginal)
+		}
+		if got := tc.schedule.AverageRate(tc.income); math.Abs(got-tc.average) > 0.000001 {
+			t.Errorf("average rate on %f = %f, expected = %f", tc.income, got, tc.average)
+		}
+	}
+}
+
+func TestRulesTaxes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rules   Rules
+		income  []float64
+		taxes   []float64
+		expired []float64
+	}{
+		{
+			"no_carryforward_limit",
+			Rules{Schedule: Flat(0.20)},
+			[]float64{-100, 50, 80, 30},
+			[]float64{0, 0, 6, 6},
+			[]float64{0, 0, 0, 0},
+		},
+		{
+			"carryforward_limit",
+			Rules{Schedule: Flat(0.20), CarryforwardLimit: 0.80},
+			[]float64{-100, 50, 80, 30},
+			[]float64{0, 2, 4, 6},
+			[]float64{0, 0, 0, 0},
+		},
+		{
+			"expiry",
+			Rules{Schedule: Flat(0.20), CarryforwardYears: 1},
+			[]float64{-100, 50, 80, 30},
+			[]float64{0, 0, 16, 6},
+			[]float64{0, 0, 50, 0},
+		},
+		{
+			"oldest_first",
+			Rules{Schedule: Flat(0.20), CarryforwardYears: 2},
+			[]float64{-30, -50, 20, 100, 100},
+			[]float64{0, 0, 0, 10, 20},
+			[]float64{0, 0, 0, 10, 0},
+		},
+		{
+			"carryback",
+			Rules{Schedule: Flat(0.20), CarrybackYears: 2},
+			[]float64{50, 30, -100, 40},
+			[]float64{10, 6, -16, 4},
+			[]float64{0, 0, 0, 0},
+		},
+		{
+			"carryback_progressive",
+			Rules{Schedule: progressive, CarrybackYears: 1},
+			[]float64{150, -100, 60},
+			[]float64{30, -25, 7},
+			[]float64{0, 0, 0},
+		},
+	}
+	for _, tc := range testCases {
+		periods, err := tc.rules.Compute(tc.income)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %s", tc.name, err)
+		}
+		taxes, _ := tc.rules.Taxes(tc.income)
+		for i := range tc.taxes {
+			if !almostEqual(taxes[i], tc.taxes[i]) {
+				t.Errorf("%s: tax in period %d = %f, expected = %f", tc.name, i, taxes[i], tc.taxes[i])
+			}
+			if !almostEqual(periods[i].LossExpired, tc.expired[i]) {
+				t.Errorf("%s: loss expired in period %d = %f, expected = %f", tc.name, i, periods[i].LossExpired, tc.expired[i])
+			}
+		}
+	}
+}
+
+func TestRulesCarryforwardBalance(t *testing.T) {
+	rules := Rules{Schedule: Flat(0.20), CarryforwardLimit: 0.80}
+	periods, err := rules.Compute([]float64{-100, 50, 80})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		used      float64
+		taxable   float64
+		remaining float64
+	}{
+		{0, 0, 100},
+		{40, 10, 60},
+		{60, 20, 0},
+	}
+	for i, tc := range testCases {
+		p := periods[i]
+		if !almostEqual(p.CarryforwardUsed, tc.used) || !almostEqual(p.TaxableIncome, tc.taxable) ||
+			!almostEqual(p.LossCarriedForward, tc.remaining) {
+			t.Errorf("period %d = %+v, expected used = %f, taxable = %f, carried forward = %f",
+				i, p, tc.used, tc.taxable, tc.remaining)
+		}
+	}
+}
+
+func TestAfterTax(t *testing.T) {
+	pretax := []float64{-100, 50, 80, 30}
+	taxes, err := Rules{Schedule: Flat(0.20)}.Taxes(pretax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := AfterTax(pretax, taxes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []float64{-100, 50, 74, 24}
+	for i := range expected {
+		if !almostEqual(after[i], expected[i]) {
+			t.Errorf("after-tax cashflow %d = %f, expected = %f", i, after[i], expected[i])
+		}
+	}
+	// Deferring the benefit of the loss makes the project worth less than
+	// applying a flat rate that refunds the loss immediately.
+	flat := []float64{-80, 40, 64, 24}
+	if npv := cf.NPV(after, 0.10); npv >= cf.NPV(flat, 0.10) {
+		t.Errorf("after-tax NPV = %f, expected less than %f", npv, cf.NPV(flat, 0.10))
+	}
+
+	if _, err := AfterTax(pretax, taxes[:2]); err == nil {
+		t.Errorf("expected an error for mismatched taxes")
+	}
+}
+
+func TestRulesErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rules Rules
+	}{
+		{"no_brackets", Rules{}},
+		{"unordered", Rules{Schedule: Schedule{{50, 0.2}, {0, 0.1}}}},
+		{"limit", Rules{Schedule: Flat(0.2), CarryforwardLimit: 1.5}},
+		{"negative_years", Rules{Schedule: Flat(0.2), CarrybackYears: -1}},
+	}
+	for _, tc := range testCases {
+		if _, err := tc.rules.Compute([]float64{100}); err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+		}
+	}
+}